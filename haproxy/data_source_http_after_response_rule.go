@@ -0,0 +1,224 @@
+package haproxy
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// HttpAfterResponseRuleSingleDataSource defines the single data source implementation.
+type HttpAfterResponseRuleSingleDataSource struct {
+	client *HAProxyClient
+}
+
+// HttpAfterResponseRuleSingleDataSourceModel describes the single data source data model.
+type HttpAfterResponseRuleSingleDataSourceModel struct {
+	ID                    types.String `tfsdk:"id"`
+	ParentType            types.String `tfsdk:"parent_type"`
+	ParentName            types.String `tfsdk:"parent_name"`
+	Index                 types.Int64  `tfsdk:"index"`
+	HttpAfterResponseRule types.String `tfsdk:"http_after_response_rule"`
+}
+
+// Metadata returns the single data source type name.
+func (d *HttpAfterResponseRuleSingleDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_http_after_response_rule_single"
+}
+
+// Schema defines the schema for the single data source.
+func (d *HttpAfterResponseRuleSingleDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Retrieves a single HTTP after-response rule by index from a specific parent (frontend or backend).\n\n## Example Usage\n\n```hcl\n# Get a specific HTTP after-response rule from a backend\ndata \"haproxy_http_after_response_rule_single\" \"set_header_rule\" {\n  parent_type = \"backend\"\n  parent_name = \"web_backend\"\n  index       = 0\n}\n\n# Use the rule data\noutput \"rule_type\" {\n  value = jsondecode(data.haproxy_http_after_response_rule_single.set_header_rule.http_after_response_rule).type\n}\n```",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "HTTP After-Response Rule identifier",
+				Computed:            true,
+			},
+			"parent_type": schema.StringAttribute{
+				MarkdownDescription: "Parent type (frontend or backend)",
+				Required:            true,
+			},
+			"parent_name": schema.StringAttribute{
+				MarkdownDescription: "Parent name",
+				Required:            true,
+			},
+			"index": schema.Int64Attribute{
+				MarkdownDescription: "HTTP After-Response Rule index",
+				Required:            true,
+			},
+			"http_after_response_rule": schema.StringAttribute{
+				MarkdownDescription: "Complete HTTP after-response rule data from HAProxy API as JSON string",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the single data source.
+func (d *HttpAfterResponseRuleSingleDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+}
+
+// Read refreshes the Terraform state with the latest data for single data source.
+func (d *HttpAfterResponseRuleSingleDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data HttpAfterResponseRuleSingleDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read the HTTP after-response rules
+	rules, err := d.client.ReadHttpAfterResponseRules(ctx, data.ParentType.ValueString(), data.ParentName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read HTTP after-response rules, got error: %s", err))
+		return
+	}
+
+	// Find the specific rule by array position (more predictable than API index)
+	var foundRule *HttpAfterResponseRulePayload
+	if data.Index.ValueInt64() < int64(len(rules)) {
+		foundRule = &rules[data.Index.ValueInt64()]
+	}
+
+	if foundRule == nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("HTTP after-response rule at position %d not found", data.Index.ValueInt64()))
+		return
+	}
+
+	// Convert to data source model
+	data.ID = types.StringValue(fmt.Sprintf("%s/%s/%d", data.ParentType.ValueString(), data.ParentName.ValueString(), data.Index.ValueInt64()))
+
+	// Fix the index to use array position instead of API index
+	foundRule.Index = data.Index.ValueInt64()
+
+	// Convert HTTP after-response rule to JSON for dynamic output
+	jsonData, err := json.Marshal(foundRule)
+	if err != nil {
+		resp.Diagnostics.AddError("JSON Error", fmt.Sprintf("Unable to marshal HTTP after-response rule to JSON, got error: %s", err))
+		return
+	}
+	data.HttpAfterResponseRule = types.StringValue(string(jsonData))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func NewHttpAfterResponseRuleDataSource() datasource.DataSource {
+	return &httpAfterResponseRuleDataSource{}
+}
+
+// NewHttpAfterResponseRuleSingleDataSource creates a new single HTTP after-response rule data source
+func NewHttpAfterResponseRuleSingleDataSource() datasource.DataSource {
+	return &HttpAfterResponseRuleSingleDataSource{}
+}
+
+type httpAfterResponseRuleDataSource struct {
+	client *HAProxyClient
+}
+
+type httpAfterResponseRuleDataSourceModel struct {
+	HttpAfterResponseRules types.String `tfsdk:"http_after_response_rules"`
+	ParentType             types.String `tfsdk:"parent_type"`
+	ParentName             types.String `tfsdk:"parent_name"`
+}
+
+func (d *httpAfterResponseRuleDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_http_after_response_rule"
+}
+
+func (d *httpAfterResponseRuleDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Retrieves all HTTP after-response rules from a specific parent (frontend or backend).\n\n## Example Usage\n\n```hcl\n# Get all HTTP after-response rules from a backend\ndata \"haproxy_http_after_response_rule\" \"backend_rules\" {\n  parent_type = \"backend\"\n  parent_name = \"web_backend\"\n}\n\n# Use the rules data\noutput \"rule_count\" {\n  value = length(jsondecode(data.haproxy_http_after_response_rule.backend_rules.http_after_response_rules))\n}\n```",
+		Attributes: map[string]schema.Attribute{
+			"http_after_response_rules": schema.StringAttribute{
+				Computed:    true,
+				Description: "Complete HTTP after-response rules data from HAProxy API as JSON string",
+			},
+			"parent_type": schema.StringAttribute{
+				Required:    true,
+				Description: "The parent type (backend or frontend).",
+			},
+			"parent_name": schema.StringAttribute{
+				Required:    true,
+				Description: "The parent name to get HTTP after-response rules for.",
+			},
+		},
+	}
+}
+
+func (d *httpAfterResponseRuleDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+}
+
+func (d *httpAfterResponseRuleDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state httpAfterResponseRuleDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	parentType := state.ParentType.ValueString()
+	parentName := state.ParentName.ValueString()
+
+	httpAfterResponseRules, err := d.client.ReadHttpAfterResponseRules(ctx, parentType, parentName)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading HAProxy HTTP After-Response Rules",
+			"Could not read HAProxy HTTP After-Response Rules, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Fix index field - use array position if API returns 0 for all rules
+	for i := range httpAfterResponseRules {
+		httpAfterResponseRules[i].Index = int64(i)
+	}
+
+	// Convert rules to JSON for dynamic output
+	jsonData, err := json.Marshal(httpAfterResponseRules)
+	if err != nil {
+		resp.Diagnostics.AddError("JSON Error", fmt.Sprintf("Unable to marshal HTTP after-response rules to JSON, got error: %s", err))
+		return
+	}
+
+	// Set JSON string directly
+	state.HttpAfterResponseRules = types.StringValue(string(jsonData))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,145 @@
+package haproxy
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the data source implements the expected interfaces.
+var (
+	_ datasource.DataSource              = &infoDataSource{}
+	_ datasource.DataSourceWithConfigure = &infoDataSource{}
+)
+
+// NewInfoDataSource is a helper function to simplify the provider implementation.
+func NewInfoDataSource() datasource.DataSource {
+	return &infoDataSource{}
+}
+
+// infoDataSource is the data source implementation.
+type infoDataSource struct {
+	client *HAProxyClient
+}
+
+// infoDataSourceModel maps the data source schema data.
+type infoDataSourceModel struct {
+	ID                 types.String `tfsdk:"id"`
+	APIVersion         types.String `tfsdk:"api_version"`
+	DataplaneVersion   types.String `tfsdk:"dataplane_version"`
+	DataplaneBuildDate types.String `tfsdk:"dataplane_build_date"`
+	HaproxyVersion     types.String `tfsdk:"haproxy_version"`
+	HaproxyReleaseDate types.String `tfsdk:"haproxy_release_date"`
+	Uptime             types.Int64  `tfsdk:"uptime"`
+	Hostname           types.String `tfsdk:"hostname"`
+}
+
+// Metadata returns the data source type name.
+func (d *infoDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_info"
+}
+
+// Schema defines the schema for the data source.
+func (d *infoDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Retrieves the versions of HAProxy and of the Data Plane API, and the uptime and hostname of the running process.\n\n## Example Usage\n\n```hcl\ndata \"haproxy_info\" \"current\" {}\n\noutput \"haproxy_version\" {\n  value = data.haproxy_info.current.haproxy_version\n}\n```",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Info identifier, the hostname",
+			},
+			"api_version": schema.StringAttribute{
+				Computed:    true,
+				Description: "The Data Plane API version used by the provider (v2 or v3), configured or detected",
+			},
+			"dataplane_version": schema.StringAttribute{
+				Computed:    true,
+				Description: "The version of the Data Plane API",
+			},
+			"dataplane_build_date": schema.StringAttribute{
+				Computed:    true,
+				Description: "The build date of the Data Plane API",
+			},
+			"haproxy_version": schema.StringAttribute{
+				Computed:    true,
+				Description: "The version of the running HAProxy process",
+			},
+			"haproxy_release_date": schema.StringAttribute{
+				Computed:    true,
+				Description: "The release date of the running HAProxy version",
+			},
+			"uptime": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The uptime of the running HAProxy process, in seconds",
+			},
+			"hostname": schema.StringAttribute{
+				Computed:    true,
+				Description: "The hostname of the HAProxy host",
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *infoDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+}
+
+func (d *infoDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data infoDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	info, err := d.client.ReadInfo(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Data Plane API info, got error: %s", err))
+		return
+	}
+	if info == nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("The Data Plane API does not serve api_version %s", d.client.GetAPIVersion()))
+		return
+	}
+
+	process, err := d.client.ReadProcessInfo(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read HAProxy process info, got error: %s", err))
+		return
+	}
+
+	hostname := info.System.Hostname
+	if hostname == "" {
+		hostname = process.Info.Node
+	}
+
+	data.ID = types.StringValue(hostname)
+	data.APIVersion = types.StringValue(d.client.GetAPIVersion())
+	data.DataplaneVersion = stringOrNull(info.API.Version)
+	data.DataplaneBuildDate = stringOrNull(info.API.BuildDate)
+	data.HaproxyVersion = stringOrNull(process.Info.Version)
+	data.HaproxyReleaseDate = stringOrNull(process.Info.ReleaseDate)
+	data.Uptime = types.Int64PointerValue(process.Info.Uptime)
+	data.Hostname = stringOrNull(hostname)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,121 @@
+package haproxy
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the data source implements the expected interfaces.
+var (
+	_ datasource.DataSource              = &resolversDataSource{}
+	_ datasource.DataSourceWithConfigure = &resolversDataSource{}
+)
+
+// NewResolversDataSource is a helper function to simplify the provider implementation.
+func NewResolversDataSource() datasource.DataSource {
+	return &resolversDataSource{}
+}
+
+// resolversDataSource is the data source implementation.
+type resolversDataSource struct {
+	client *HAProxyClient
+}
+
+// resolversDataSourceModel maps the data source schema data.
+type resolversDataSourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	Name      types.String `tfsdk:"name"`
+	Resolvers types.String `tfsdk:"resolvers"`
+}
+
+// Metadata returns the data source type name.
+func (d *resolversDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_resolvers"
+}
+
+// Schema defines the schema for the data source.
+func (d *resolversDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Retrieves a resolvers section and its nameservers.\n\n## Example Usage\n\n```hcl\ndata \"haproxy_resolvers\" \"dns\" {\n  name = \"internal_dns\"\n}\n\noutput \"nameserver_addresses\" {\n  value = [for ns in jsondecode(data.haproxy_resolvers.dns.resolvers).nameservers : ns.address]\n}\n```",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Resolvers identifier",
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Resolvers section name",
+			},
+			"resolvers": schema.StringAttribute{
+				Computed:    true,
+				Description: "Complete resolvers data, including nameservers, from HAProxy API as JSON string",
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *resolversDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+}
+
+func (d *resolversDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data resolversDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := data.Name.ValueString()
+	resolver, err := d.client.ReadResolver(ctx, name)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read resolvers %s, got error: %s", name, err))
+		return
+	}
+	if resolver == nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Resolvers with name %s not found", name))
+		return
+	}
+
+	nameservers, err := d.client.ReadNameservers(ctx, name)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read nameservers of %s, got error: %s", name, err))
+		return
+	}
+
+	// Convert resolvers to JSON for dynamic output
+	jsonData, err := json.Marshal(struct {
+		*ResolverPayload
+		Nameservers []NameserverPayload `json:"nameservers"`
+	}{resolver, nameservers})
+	if err != nil {
+		resp.Diagnostics.AddError("JSON Error", fmt.Sprintf("Unable to marshal resolvers to JSON, got error: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue(name)
+	data.Resolvers = types.StringValue(string(jsonData))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
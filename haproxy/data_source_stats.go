@@ -0,0 +1,185 @@
+package haproxy
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the data source implements the expected interfaces.
+var (
+	_ datasource.DataSource              = &statsDataSource{}
+	_ datasource.DataSourceWithConfigure = &statsDataSource{}
+)
+
+// NewStatsDataSource is a helper function to simplify the provider implementation.
+func NewStatsDataSource() datasource.DataSource {
+	return &statsDataSource{}
+}
+
+// statsDataSource is the data source implementation.
+type statsDataSource struct {
+	client *HAProxyClient
+}
+
+// statsDataSourceModel maps the data source schema data.
+type statsDataSourceModel struct {
+	ID     types.String       `tfsdk:"id"`
+	Type   types.String       `tfsdk:"type"`
+	Name   types.String       `tfsdk:"name"`
+	Parent types.String       `tfsdk:"parent"`
+	Stats  []haproxyStatModel `tfsdk:"stats"`
+}
+
+// haproxyStatModel maps a statistics line of the data source.
+type haproxyStatModel struct {
+	Type        types.String `tfsdk:"type"`
+	Name        types.String `tfsdk:"name"`
+	BackendName types.String `tfsdk:"backend_name"`
+	Status      types.String `tfsdk:"status"`
+	CheckStatus types.String `tfsdk:"check_status"`
+	Scur        types.Int64  `tfsdk:"scur"`
+	Smax        types.Int64  `tfsdk:"smax"`
+	Slim        types.Int64  `tfsdk:"slim"`
+	Stot        types.Int64  `tfsdk:"stot"`
+	Rate        types.Int64  `tfsdk:"rate"`
+	RateMax     types.Int64  `tfsdk:"rate_max"`
+	Bin         types.Int64  `tfsdk:"bin"`
+	Bout        types.Int64  `tfsdk:"bout"`
+	Ereq        types.Int64  `tfsdk:"ereq"`
+	Econ        types.Int64  `tfsdk:"econ"`
+	Eresp       types.Int64  `tfsdk:"eresp"`
+	Weight      types.Int64  `tfsdk:"weight"`
+}
+
+// Metadata returns the data source type name.
+func (d *statsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_stats"
+}
+
+// Schema defines the schema for the data source.
+func (d *statsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Retrieves live statistics of the running HAProxy process from the native stats endpoint.\n\n## Example Usage\n\n```hcl\ndata \"haproxy_stats\" \"web_servers\" {\n  type   = \"server\"\n  parent = \"web_backend\"\n}\n\noutput \"servers_down\" {\n  value = [for s in data.haproxy_stats.web_servers.stats : s.name if s.status != \"UP\"]\n}\n```",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Stats identifier, built from the filters",
+			},
+			"type": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return lines of this type (frontend, backend or server)",
+				Validators: []validator.String{
+					stringvalidator.OneOf("frontend", "backend", "server"),
+				},
+			},
+			"name": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return lines of the frontend, backend or server with this name",
+			},
+			"parent": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return the servers of this backend; used with type server",
+			},
+			"stats": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The matching statistics lines",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type":         schema.StringAttribute{Computed: true, Description: "The type of the line (frontend, backend or server)"},
+						"name":         schema.StringAttribute{Computed: true, Description: "The name of the frontend, backend or server"},
+						"backend_name": schema.StringAttribute{Computed: true, Description: "The backend of a server line"},
+						"status":       schema.StringAttribute{Computed: true, Description: "The status (e.g. OPEN, UP, DOWN, MAINT, DRAIN, no check)"},
+						"check_status": schema.StringAttribute{Computed: true, Description: "The status of the last health check of a server (e.g. L7OK, L4TOUT)"},
+						"scur":         schema.Int64Attribute{Computed: true, Description: "The current number of sessions"},
+						"smax":         schema.Int64Attribute{Computed: true, Description: "The maximum number of concurrent sessions"},
+						"slim":         schema.Int64Attribute{Computed: true, Description: "The configured session limit"},
+						"stot":         schema.Int64Attribute{Computed: true, Description: "The total number of sessions"},
+						"rate":         schema.Int64Attribute{Computed: true, Description: "The number of sessions per second over the last second"},
+						"rate_max":     schema.Int64Attribute{Computed: true, Description: "The maximum number of sessions per second"},
+						"bin":          schema.Int64Attribute{Computed: true, Description: "The total number of bytes received"},
+						"bout":         schema.Int64Attribute{Computed: true, Description: "The total number of bytes sent"},
+						"ereq":         schema.Int64Attribute{Computed: true, Description: "The number of request errors"},
+						"econ":         schema.Int64Attribute{Computed: true, Description: "The number of errors connecting to a server"},
+						"eresp":        schema.Int64Attribute{Computed: true, Description: "The number of response errors"},
+						"weight":       schema.Int64Attribute{Computed: true, Description: "The effective weight of a server, or the total weight of a backend"},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *statsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+}
+
+func (d *statsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data statsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	statType, name, parent := data.Type.ValueString(), data.Name.ValueString(), data.Parent.ValueString()
+	stats, err := d.client.ReadNativeStats(ctx, statType, name, parent)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read stats, got error: %s", err))
+		return
+	}
+
+	data.Stats = []haproxyStatModel{}
+	for _, stat := range stats {
+		// Filter again in case the API version ignores some of the query parameters
+		if (statType != "" && stat.Type != statType) || (name != "" && stat.Name != name) ||
+			(parent != "" && stat.Type == "server" && stat.BackendName != parent) {
+			continue
+		}
+		data.Stats = append(data.Stats, haproxyStatModel{
+			Type:        types.StringValue(stat.Type),
+			Name:        stringOrNull(stat.Name),
+			BackendName: stringOrNull(stat.BackendName),
+			Status:      stringOrNull(stat.Stats.Status),
+			CheckStatus: stringOrNull(stat.Stats.CheckStatus),
+			Scur:        types.Int64PointerValue(stat.Stats.Scur),
+			Smax:        types.Int64PointerValue(stat.Stats.Smax),
+			Slim:        types.Int64PointerValue(stat.Stats.Slim),
+			Stot:        types.Int64PointerValue(stat.Stats.Stot),
+			Rate:        types.Int64PointerValue(stat.Stats.Rate),
+			RateMax:     types.Int64PointerValue(stat.Stats.RateMax),
+			Bin:         types.Int64PointerValue(stat.Stats.Bin),
+			Bout:        types.Int64PointerValue(stat.Stats.Bout),
+			Ereq:        types.Int64PointerValue(stat.Stats.Ereq),
+			Econ:        types.Int64PointerValue(stat.Stats.Econ),
+			Eresp:       types.Int64PointerValue(stat.Stats.Eresp),
+			Weight:      types.Int64PointerValue(stat.Stats.Weight),
+		})
+	}
+
+	data.ID = types.StringValue(strings.Join([]string{statType, parent, name}, "/"))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
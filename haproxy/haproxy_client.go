@@ -7,8 +7,11 @@ import (
 	"fmt"
 	"io"
 	"log"
+	"mime/multipart"
 	"net/http"
+	"net/url"
 	"regexp"
+	"sort"
 	"strings"
 	"time"
 
@@ -200,7 +203,7 @@ func (c *HAProxyClient) CreateACLInTransaction(ctx context.Context, transactionI
 		// v3: Use nested endpoint with index-based positioning
 		// Use the actual index from the payload for proper ordering
 		// Properly pluralize the parent type
-		parentTypePlural := parentType + "s"
+		parentTypePlural := pluralParentType(parentType)
 		url = fmt.Sprintf("/services/haproxy/configuration/%s/%s/acls/%d?transaction_id=%s",
 			parentTypePlural, parentName, payload.Index, transactionID)
 	} else {
@@ -242,7 +245,7 @@ func (c *HAProxyClient) CreateAllACLsInTransaction(ctx context.Context, transact
 
 	if c.apiVersion == "v3" {
 		// v3: Use nested endpoint under frontends/backends - send all at once
-		parentTypePlural := parentType + "s"
+		parentTypePlural := pluralParentType(parentType)
 		url = fmt.Sprintf("/services/haproxy/configuration/%s/%s/acls?transaction_id=%s",
 			parentTypePlural, parentName, transactionID)
 		method = httpMethodPUT
@@ -330,7 +333,7 @@ func (c *HAProxyClient) UpdateACLInTransaction(ctx context.Context, transactionI
 	if c.apiVersion == "v3" {
 		// v3: Use nested endpoint with index-based positioning
 		// Properly pluralize the parent type
-		parentTypePlural := parentType + "s"
+		parentTypePlural := pluralParentType(parentType)
 		url = fmt.Sprintf("/services/haproxy/configuration/%s/%s/acls/%d?transaction_id=%s",
 			parentTypePlural, parentName, index, transactionID)
 	} else {
@@ -372,7 +375,7 @@ func (c *HAProxyClient) DeleteACLInTransaction(ctx context.Context, transactionI
 	if c.apiVersion == "v3" {
 		// v3: Use nested endpoint with index-based positioning
 		// Properly pluralize the parent type
-		parentTypePlural := parentType + "s"
+		parentTypePlural := pluralParentType(parentType)
 		url = fmt.Sprintf("/services/haproxy/configuration/%s/%s/acls/%d?transaction_id=%s",
 			parentTypePlural, parentName, index, transactionID)
 	} else {
@@ -413,7 +416,7 @@ func (c *HAProxyClient) ReadACLs(ctx context.Context, parentType, parentName str
 	if c.apiVersion == "v3" {
 		// v3: Use nested endpoint under frontends/backends
 		// Properly pluralize the parent type
-		parentTypePlural := parentType + "s"
+		parentTypePlural := pluralParentType(parentType)
 		url = fmt.Sprintf("/services/haproxy/configuration/%s/%s/acls", parentTypePlural, parentName)
 	} else {
 		// v2: Use query parameter approach
@@ -1279,7 +1282,7 @@ func (c *HAProxyClient) CreateServerInTransaction(ctx context.Context, transacti
 	if c.apiVersion == "v3" {
 		// v3: Use nested endpoint under backends
 		// Properly pluralize the parent type
-		parentTypePlural := parentType + "s"
+		parentTypePlural := pluralParentType(parentType)
 		url = fmt.Sprintf("/services/haproxy/configuration/%s/%s/servers?transaction_id=%s",
 			parentTypePlural, parentName, transactionID)
 	} else {
@@ -1321,7 +1324,7 @@ func (c *HAProxyClient) UpdateServerInTransaction(ctx context.Context, transacti
 	if c.apiVersion == "v3" {
 		// v3: Use nested endpoint under backends
 		// Properly pluralize the parent type
-		parentTypePlural := parentType + "s"
+		parentTypePlural := pluralParentType(parentType)
 		url = fmt.Sprintf("/services/haproxy/configuration/%s/%s/servers/%s?transaction_id=%s",
 			parentTypePlural, parentName, payload.Name, transactionID)
 	} else {
@@ -1363,7 +1366,7 @@ func (c *HAProxyClient) DeleteServerInTransaction(ctx context.Context, transacti
 	if c.apiVersion == "v3" {
 		// v3: Use nested endpoint under backends
 		// Properly pluralize the parent type
-		parentTypePlural := parentType + "s"
+		parentTypePlural := pluralParentType(parentType)
 		url = fmt.Sprintf("/services/haproxy/configuration/%s/%s/servers/%s?transaction_id=%s",
 			parentTypePlural, parentName, serverName, transactionID)
 	} else {
@@ -1615,14 +1618,9 @@ func (c *HAProxyClient) ReadBinds(ctx context.Context, parentType, parentName st
 
 	// Construct URL based on API version
 	if c.apiVersion == "v3" {
-		// v3: nested under parent resource (note: frontend -> frontends, backend -> backends)
-		if parentType == "frontend" {
-			parentType = "frontends"
-		} else if parentType == "backend" {
-			parentType = "backends"
-		}
+		// v3: nested under parent resource (note: frontend -> frontends, log_forward -> log_forwards)
 		// Use same format as CreateBindsInTransaction (without /v3 prefix)
-		url = fmt.Sprintf("/services/haproxy/configuration/%s/%s/binds", parentType, parentName)
+		url = fmt.Sprintf("/services/haproxy/configuration/%s/%s/binds", pluralParentType(parentType), parentName)
 	} else {
 		// v2: query parameters (no version prefix needed)
 		url = fmt.Sprintf("/services/haproxy/configuration/binds?parent_type=%s&parent_name=%s", parentType, parentName)
@@ -1713,7 +1711,7 @@ func (c *HAProxyClient) ReadAcls(ctx context.Context, parentType, parentName str
 	var url string
 	if c.apiVersion == "v3" {
 		// v3: Use nested endpoint under frontends/backends
-		parentTypePlural := parentType + "s"
+		parentTypePlural := pluralParentType(parentType)
 		url = fmt.Sprintf("/services/haproxy/configuration/%s/%s/acls", parentTypePlural, parentName)
 	} else {
 		// v2: Use query parameter approach
@@ -1834,7 +1832,7 @@ func (c *HAProxyClient) ReadHttpRequestRules(ctx context.Context, parentType, pa
 	var url string
 	if c.apiVersion == "v3" {
 		// v3: Use nested endpoint under frontends/backends
-		parentTypePlural := parentType + "s"
+		parentTypePlural := pluralParentType(parentType)
 		url = fmt.Sprintf("/services/haproxy/configuration/%s/%s/http_request_rules", parentTypePlural, parentName)
 	} else {
 		// v2: Use query parameter approach
@@ -1943,7 +1941,7 @@ func (c *HAProxyClient) ReadHttpResponseRules(ctx context.Context, parentType, p
 	var url string
 	if c.apiVersion == "v3" {
 		// v3: Use nested endpoint under frontends/backends
-		parentTypePlural := parentType + "s"
+		parentTypePlural := pluralParentType(parentType)
 		url = fmt.Sprintf("/services/haproxy/configuration/%s/%s/http_response_rules", parentTypePlural, parentName)
 	} else {
 		// v2: Use query parameter approach
@@ -2199,33 +2197,72 @@ func (c *HAProxyClient) ReadNameserver(ctx context.Context, name, resolver strin
 
 // ReadNameservers reads all nameservers for a resolver.
 func (c *HAProxyClient) ReadNameservers(ctx context.Context, resolver string) ([]NameserverPayload, error) {
-	req, err := c.newRequest(ctx, "GET", fmt.Sprintf("/services/haproxy/configuration/nameservers?resolver=%s", resolver), nil)
-	if err != nil {
+	nameservers := []NameserverPayload{}
+	// No nameservers found is not an error
+	if _, err := c.getJSON(ctx, c.nameserversURL(resolver, "", ""), &nameservers); err != nil {
 		return nil, err
 	}
+	return nameservers, nil
+}
 
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
+// CreateResolverInTransaction creates a new resolver using an existing transaction ID.
+func (c *HAProxyClient) CreateResolverInTransaction(ctx context.Context, transactionID string, payload *ResolverPayload) error {
+	url := fmt.Sprintf("/services/haproxy/configuration/resolvers?transaction_id=%s", transactionID)
+	return c.sendInTransaction(ctx, "POST", url, payload, "resolver creation")
+}
 
-	if resp.StatusCode == http.StatusNotFound {
-		return []NameserverPayload{}, nil // No nameservers found is not an error
-	}
+// UpdateResolverInTransaction updates a resolver using an existing transaction ID.
+func (c *HAProxyClient) UpdateResolverInTransaction(ctx context.Context, transactionID, name string, payload *ResolverPayload) error {
+	url := fmt.Sprintf("/services/haproxy/configuration/resolvers/%s?transaction_id=%s", name, transactionID)
+	return c.sendInTransaction(ctx, "PUT", url, payload, "resolver update")
+}
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+// DeleteResolverInTransaction deletes a resolver using an existing transaction ID.
+func (c *HAProxyClient) DeleteResolverInTransaction(ctx context.Context, transactionID, name string) error {
+	url := fmt.Sprintf("/services/haproxy/configuration/resolvers/%s?transaction_id=%s", name, transactionID)
+	return c.sendInTransaction(ctx, "DELETE", url, nil, "resolver deletion")
+}
+
+// CreateNameserverInTransaction creates a new nameserver using an existing transaction ID.
+func (c *HAProxyClient) CreateNameserverInTransaction(ctx context.Context, transactionID, resolver string, payload *NameserverPayload) error {
+	return c.sendInTransaction(ctx, "POST", c.nameserversURL(resolver, "", transactionID), payload, "nameserver creation")
+}
+
+// UpdateNameserverInTransaction updates a nameserver using an existing transaction ID.
+func (c *HAProxyClient) UpdateNameserverInTransaction(ctx context.Context, transactionID, resolver string, payload *NameserverPayload) error {
+	return c.sendInTransaction(ctx, "PUT", c.nameserversURL(resolver, payload.Name, transactionID), payload, "nameserver update")
+}
+
+// DeleteNameserverInTransaction deletes a nameserver using an existing transaction ID.
+func (c *HAProxyClient) DeleteNameserverInTransaction(ctx context.Context, transactionID, resolver, name string) error {
+	return c.sendInTransaction(ctx, "DELETE", c.nameserversURL(resolver, name, transactionID), nil, "nameserver deletion")
+}
+
+// nameserversURL builds the nameserver endpoint for a resolver; name and transactionID are optional.
+func (c *HAProxyClient) nameserversURL(resolver, name, transactionID string) string {
+	var url string
+	if c.apiVersion == "v3" {
+		// v3: nested under the resolver section
+		url = fmt.Sprintf("/services/haproxy/configuration/resolvers/%s/nameservers", resolver)
+		if name != "" {
+			url += "/" + name
+		}
+		if transactionID != "" {
+			url += "?transaction_id=" + transactionID
+		}
+		return url
 	}
 
-	var nameserversWrapper struct {
-		Data []NameserverPayload `json:"data"`
+	// v2: resolver passed as a query parameter
+	url = "/services/haproxy/configuration/nameservers"
+	if name != "" {
+		url += "/" + name
 	}
-	if err := json.NewDecoder(resp.Body).Decode(&nameserversWrapper); err != nil {
-		return nil, err
+	url += "?resolver=" + resolver
+	if transactionID != "" {
+		url += "&transaction_id=" + transactionID
 	}
-
-	return nameserversWrapper.Data, nil
+	return url
 }
 
 // UpdateNameserver updates a nameserver.
@@ -2275,33 +2312,12 @@ func (c *HAProxyClient) CreatePeers(ctx context.Context, payload *PeersPayload)
 
 // ReadPeers reads a peers.
 func (c *HAProxyClient) ReadPeers(ctx context.Context, name string) (*PeersPayload, error) {
-	req, err := c.newRequest(ctx, "GET", fmt.Sprintf("/services/haproxy/configuration/peers/%s", name), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode == http.StatusNotFound {
-		return nil, nil
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
-	}
-
-	var peersWrapper struct {
-		Data PeersPayload `json:"data"`
-	}
-	if err := json.NewDecoder(resp.Body).Decode(&peersWrapper); err != nil {
+	var peers PeersPayload
+	found, err := c.getJSON(ctx, fmt.Sprintf("/services/haproxy/configuration/peers/%s", name), &peers)
+	if err != nil || !found {
 		return nil, err
 	}
-
-	return &peersWrapper.Data, nil
+	return &peers, nil
 }
 
 // UpdatePeers updates a peers.
@@ -2382,33 +2398,12 @@ func (c *HAProxyClient) ReadPeerEntry(ctx context.Context, name, peers string) (
 
 // ReadPeerEntries reads all peer entries for a peers group.
 func (c *HAProxyClient) ReadPeerEntries(ctx context.Context, peers string) ([]PeerEntryPayload, error) {
-	req, err := c.newRequest(ctx, "GET", fmt.Sprintf("/services/haproxy/configuration/peer_entries?peers=%s", peers), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode == http.StatusNotFound {
-		return []PeerEntryPayload{}, nil // No peer entries found is not an error
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
-	}
-
-	var peerEntriesWrapper struct {
-		Data []PeerEntryPayload `json:"data"`
-	}
-	if err := json.NewDecoder(resp.Body).Decode(&peerEntriesWrapper); err != nil {
+	entries := []PeerEntryPayload{}
+	// No peer entries found is not an error
+	if _, err := c.getJSON(ctx, c.peerEntriesURL(peers, "", ""), &entries); err != nil {
 		return nil, err
 	}
-
-	return peerEntriesWrapper.Data, nil
+	return entries, nil
 }
 
 // UpdatePeerEntry updates a peer_entry.
@@ -2441,24 +2436,202 @@ func (c *HAProxyClient) DeletePeerEntry(ctx context.Context, name, peers string)
 	return err
 }
 
-// CreateStickRule creates a new stick_rule.
-func (c *HAProxyClient) CreateStickRule(ctx context.Context, backend string, payload *StickRulePayload) error {
-	resp, err := c.Transaction(func(transactionID string) (*http.Response, error) {
-		req, err := c.newRequest(ctx, "POST", fmt.Sprintf("/services/haproxy/configuration/stick_rules?backend=%s&transaction_id=%s", backend, transactionID), payload)
-		if err != nil {
-			return nil, err
+// CreatePeersInTransaction creates a new peers section using an existing transaction ID.
+func (c *HAProxyClient) CreatePeersInTransaction(ctx context.Context, transactionID string, payload *PeersPayload) error {
+	url := fmt.Sprintf("/services/haproxy/configuration/peers?transaction_id=%s", transactionID)
+	return c.sendInTransaction(ctx, "POST", url, payload, "peers creation")
+}
+
+// DeletePeersInTransaction deletes a peers section using an existing transaction ID.
+func (c *HAProxyClient) DeletePeersInTransaction(ctx context.Context, transactionID, name string) error {
+	url := fmt.Sprintf("/services/haproxy/configuration/peers/%s?transaction_id=%s", name, transactionID)
+	return c.sendInTransaction(ctx, "DELETE", url, nil, "peers deletion")
+}
+
+// CreatePeerEntryInTransaction creates a new peer entry using an existing transaction ID.
+func (c *HAProxyClient) CreatePeerEntryInTransaction(ctx context.Context, transactionID, peers string, payload *PeerEntryPayload) error {
+	return c.sendInTransaction(ctx, "POST", c.peerEntriesURL(peers, "", transactionID), payload, "peer entry creation")
+}
+
+// UpdatePeerEntryInTransaction updates a peer entry using an existing transaction ID.
+func (c *HAProxyClient) UpdatePeerEntryInTransaction(ctx context.Context, transactionID, peers string, payload *PeerEntryPayload) error {
+	return c.sendInTransaction(ctx, "PUT", c.peerEntriesURL(peers, payload.Name, transactionID), payload, "peer entry update")
+}
+
+// DeletePeerEntryInTransaction deletes a peer entry using an existing transaction ID.
+func (c *HAProxyClient) DeletePeerEntryInTransaction(ctx context.Context, transactionID, peers, name string) error {
+	return c.sendInTransaction(ctx, "DELETE", c.peerEntriesURL(peers, name, transactionID), nil, "peer entry deletion")
+}
+
+// peerEntriesURL builds the peer entry endpoint for a peers section; name and transactionID are optional.
+func (c *HAProxyClient) peerEntriesURL(peers, name, transactionID string) string {
+	var url string
+	if c.apiVersion == "v3" {
+		// v3: nested under the peers section
+		url = fmt.Sprintf("/services/haproxy/configuration/peers/%s/peer_entries", peers)
+		if name != "" {
+			url += "/" + name
 		}
-		return c.httpClient.Do(req)
-	})
-	if resp != nil {
-		defer resp.Body.Close()
+		if transactionID != "" {
+			url += "?transaction_id=" + transactionID
+		}
+		return url
 	}
-	return err
+
+	// v2: peers section passed as a query parameter
+	url = "/services/haproxy/configuration/peer_entries"
+	if name != "" {
+		url += "/" + name
+	}
+	url += "?peers=" + peers
+	if transactionID != "" {
+		url += "&transaction_id=" + transactionID
+	}
+	return url
 }
 
-// ReadStickRule reads a stick_rule.
-func (c *HAProxyClient) ReadStickRule(ctx context.Context, index int64, backend string) (*StickRulePayload, error) {
-	req, err := c.newRequest(ctx, "GET", fmt.Sprintf("/services/haproxy/configuration/stick_rules/%d?backend=%s", index, backend), nil)
+// ReadCache reads a cache section.
+func (c *HAProxyClient) ReadCache(ctx context.Context, name string) (*CachePayload, error) {
+	var cache CachePayload
+	found, err := c.getJSON(ctx, fmt.Sprintf("/services/haproxy/configuration/caches/%s", name), &cache)
+	if err != nil || !found {
+		return nil, err
+	}
+	return &cache, nil
+}
+
+// CreateCacheInTransaction creates a new cache section using an existing transaction ID.
+func (c *HAProxyClient) CreateCacheInTransaction(ctx context.Context, transactionID string, payload *CachePayload) error {
+	url := fmt.Sprintf("/services/haproxy/configuration/caches?transaction_id=%s", transactionID)
+	return c.sendInTransaction(ctx, "POST", url, payload, "cache creation")
+}
+
+// UpdateCacheInTransaction updates a cache section using an existing transaction ID.
+func (c *HAProxyClient) UpdateCacheInTransaction(ctx context.Context, transactionID string, payload *CachePayload) error {
+	url := fmt.Sprintf("/services/haproxy/configuration/caches/%s?transaction_id=%s", payload.Name, transactionID)
+	return c.sendInTransaction(ctx, "PUT", url, payload, "cache update")
+}
+
+// DeleteCacheInTransaction deletes a cache section using an existing transaction ID.
+func (c *HAProxyClient) DeleteCacheInTransaction(ctx context.Context, transactionID, name string) error {
+	url := fmt.Sprintf("/services/haproxy/configuration/caches/%s?transaction_id=%s", name, transactionID)
+	return c.sendInTransaction(ctx, "DELETE", url, nil, "cache deletion")
+}
+
+// ReadHttpErrors reads an http-errors section.
+func (c *HAProxyClient) ReadHttpErrors(ctx context.Context, name string) (*HttpErrorsPayload, error) {
+	var section HttpErrorsPayload
+	found, err := c.getJSON(ctx, fmt.Sprintf("/services/haproxy/configuration/http_errors_sections/%s", name), &section)
+	if err != nil || !found {
+		return nil, err
+	}
+	return &section, nil
+}
+
+// CreateHttpErrorsInTransaction creates a new http-errors section using an existing transaction ID.
+func (c *HAProxyClient) CreateHttpErrorsInTransaction(ctx context.Context, transactionID string, payload *HttpErrorsPayload) error {
+	url := fmt.Sprintf("/services/haproxy/configuration/http_errors_sections?transaction_id=%s", transactionID)
+	return c.sendInTransaction(ctx, "POST", url, payload, "http-errors creation")
+}
+
+// UpdateHttpErrorsInTransaction updates an http-errors section using an existing transaction ID.
+func (c *HAProxyClient) UpdateHttpErrorsInTransaction(ctx context.Context, transactionID string, payload *HttpErrorsPayload) error {
+	url := fmt.Sprintf("/services/haproxy/configuration/http_errors_sections/%s?transaction_id=%s", payload.Name, transactionID)
+	return c.sendInTransaction(ctx, "PUT", url, payload, "http-errors update")
+}
+
+// DeleteHttpErrorsInTransaction deletes an http-errors section using an existing transaction ID.
+func (c *HAProxyClient) DeleteHttpErrorsInTransaction(ctx context.Context, transactionID, name string) error {
+	url := fmt.Sprintf("/services/haproxy/configuration/http_errors_sections/%s?transaction_id=%s", name, transactionID)
+	return c.sendInTransaction(ctx, "DELETE", url, nil, "http-errors deletion")
+}
+
+// WriteGeneralFile stores content in the general storage under name, replacing any existing
+// file, and returns the path HAProxy reads the file from. Storage is not transactional, so
+// files must be written before the transaction that references them is committed.
+func (c *HAProxyClient) WriteGeneralFile(ctx context.Context, name string, content []byte) (string, error) {
+	var file GeneralFilePayload
+	status, err := c.uploadStorageFile(ctx, httpMethodPOST, "/services/haproxy/storage/general", name, content, &file)
+	if err == nil && status == http.StatusConflict {
+		_, err = c.uploadStorageFile(ctx, httpMethodPUT, "/services/haproxy/storage/general/"+name, name, content, &file)
+	}
+	if err != nil {
+		return "", err
+	}
+	if file.File != "" {
+		return file.File, nil
+	}
+
+	// Some API versions answer a replacement without a body; look the path up instead
+	files := []GeneralFilePayload{}
+	if _, err := c.getJSON(ctx, "/services/haproxy/storage/general", &files); err != nil {
+		return "", err
+	}
+	for _, f := range files {
+		if f.StorageName == name {
+			return f.File, nil
+		}
+	}
+	return "", fmt.Errorf("general storage file %s not found after upload", name)
+}
+
+// uploadStorageFile sends content as a multipart upload and decodes the response into out.
+// A 409 Conflict is returned as a status without error so the caller can decide whether to
+// replace the existing file.
+func (c *HAProxyClient) uploadStorageFile(ctx context.Context, method, path, name string, content []byte, out interface{}) (int, error) {
+	var buf bytes.Buffer
+	writer := multipart.NewWriter(&buf)
+	part, err := writer.CreateFormFile("file_upload", name)
+	if err != nil {
+		return 0, err
+	}
+	if _, err := part.Write(content); err != nil {
+		return 0, err
+	}
+	if err := writer.Close(); err != nil {
+		return 0, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, fmt.Sprintf("%s/%s%s", c.baseURL, c.apiVersion, path), &buf)
+	if err != nil {
+		return 0, err
+	}
+	req.SetBasicAuth(c.username, c.password)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+
+	return c.doStorageRequest(req, name, out)
+}
+
+// doStorageRequest sends a storage write request and decodes a non-empty response body into out.
+func (c *HAProxyClient) doStorageRequest(req *http.Request, name string, out interface{}) (int, error) {
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return resp.StatusCode, err
+	}
+	if resp.StatusCode == http.StatusConflict {
+		return resp.StatusCode, nil
+	}
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return resp.StatusCode, fmt.Errorf("storage upload of %s failed with status %d: %s", name, resp.StatusCode, string(body))
+	}
+
+	if len(bytes.TrimSpace(body)) > 0 {
+		if err := json.Unmarshal(body, out); err != nil {
+			return resp.StatusCode, err
+		}
+	}
+	return resp.StatusCode, nil
+}
+
+// ReadGeneralFile returns the content of a general storage file, or nil if it does not exist.
+func (c *HAProxyClient) ReadGeneralFile(ctx context.Context, name string) ([]byte, error) {
+	req, err := c.newRequest(ctx, httpMethodGET, "/services/haproxy/storage/general/"+name, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -2472,80 +2645,898 @@ func (c *HAProxyClient) ReadStickRule(ctx context.Context, index int64, backend
 	if resp.StatusCode == http.StatusNotFound {
 		return nil, nil
 	}
-
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode, string(body))
 	}
+	return body, nil
+}
 
-	var stickRuleWrapper struct {
-		Data StickRulePayload `json:"data"`
+// DeleteGeneralFile deletes a general storage file. A file that no longer exists is not an error.
+func (c *HAProxyClient) DeleteGeneralFile(ctx context.Context, name string) error {
+	return c.deleteStorageFile(ctx, "/services/haproxy/storage/general/"+name, name)
+}
+
+// ReadSslCertificate reads the description of a stored certificate, or nil if it does not exist.
+func (c *HAProxyClient) ReadSslCertificate(ctx context.Context, name string) (*SslCertificatePayload, error) {
+	var certificate SslCertificatePayload
+	found, err := c.getJSON(ctx, "/services/haproxy/storage/ssl_certificates/"+name, &certificate)
+	if err != nil || !found {
+		return nil, err
 	}
-	if err := json.NewDecoder(resp.Body).Decode(&stickRuleWrapper); err != nil {
+	return &certificate, nil
+}
+
+// CreateSslCertificate uploads a new PEM file to the certificate storage. Unlike general
+// files, an existing certificate is never overwritten.
+func (c *HAProxyClient) CreateSslCertificate(ctx context.Context, name string, content []byte) (*SslCertificatePayload, error) {
+	var certificate SslCertificatePayload
+	status, err := c.uploadStorageFile(ctx, httpMethodPOST, "/services/haproxy/storage/ssl_certificates", name, content, &certificate)
+	if err != nil {
 		return nil, err
 	}
+	if status == http.StatusConflict {
+		return nil, fmt.Errorf("certificate %s already exists in the storage", name)
+	}
+	return &certificate, nil
+}
 
-	return &stickRuleWrapper.Data, nil
+// ReplaceSslCertificate replaces the content of a stored PEM file. HAProxy is reloaded by the
+// Data Plane API so that binds and servers pick up the new certificate.
+func (c *HAProxyClient) ReplaceSslCertificate(ctx context.Context, name string, content []byte) (*SslCertificatePayload, error) {
+	var certificate SslCertificatePayload
+	if err := c.replaceStorageFile(ctx, "/services/haproxy/storage/ssl_certificates/"+name, name, content, &certificate); err != nil {
+		return nil, err
+	}
+	return &certificate, nil
 }
 
-// ReadStickRules reads all stick rules for a backend.
-func (c *HAProxyClient) ReadStickRules(ctx context.Context, backend string) ([]StickRulePayload, error) {
-	req, err := c.newRequest(ctx, "GET", fmt.Sprintf("/services/haproxy/configuration/stick_rules?backend=%s", backend), nil)
+// DeleteSslCertificate deletes a stored PEM file. A file that no longer exists is not an error.
+func (c *HAProxyClient) DeleteSslCertificate(ctx context.Context, name string) error {
+	return c.deleteStorageFile(ctx, "/services/haproxy/storage/ssl_certificates/"+name, name)
+}
+
+// ReadMapFile reads the description of a stored map file, or nil if it does not exist. The
+// storage only lists map files, so the description is looked up by storage name.
+func (c *HAProxyClient) ReadMapFile(ctx context.Context, name string) (*MapFilePayload, error) {
+	files := []MapFilePayload{}
+	if _, err := c.getJSON(ctx, "/services/haproxy/storage/maps", &files); err != nil {
+		return nil, err
+	}
+	for _, file := range files {
+		if file.StorageName == name {
+			return &file, nil
+		}
+	}
+	return nil, nil
+}
+
+// CreateMapFile uploads a new map file to the map storage. An existing map file is never
+// overwritten.
+func (c *HAProxyClient) CreateMapFile(ctx context.Context, name string, content []byte) (*MapFilePayload, error) {
+	var file MapFilePayload
+	status, err := c.uploadStorageFile(ctx, httpMethodPOST, "/services/haproxy/storage/maps", name, content, &file)
 	if err != nil {
 		return nil, err
 	}
+	if status == http.StatusConflict {
+		return nil, fmt.Errorf("map file %s already exists in the storage", name)
+	}
+	return &file, nil
+}
+
+// ReplaceMapFile replaces the content of a stored map file. Entries added at runtime are
+// lost unless they are part of content.
+func (c *HAProxyClient) ReplaceMapFile(ctx context.Context, name string, content []byte) (*MapFilePayload, error) {
+	var file MapFilePayload
+	if err := c.replaceStorageFile(ctx, "/services/haproxy/storage/maps/"+name, name, content, &file); err != nil {
+		return nil, err
+	}
+	return &file, nil
+}
+
+// DeleteMapFile deletes a stored map file. A file that no longer exists is not an error.
+func (c *HAProxyClient) DeleteMapFile(ctx context.Context, name string) error {
+	return c.deleteStorageFile(ctx, "/services/haproxy/storage/maps/"+name, name)
+}
+
+// replaceStorageFile replaces the content of an existing storage file with a raw body.
+func (c *HAProxyClient) replaceStorageFile(ctx context.Context, path, name string, content []byte, out interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, httpMethodPUT, fmt.Sprintf("%s/%s%s", c.baseURL, c.apiVersion, path), bytes.NewReader(content))
+	if err != nil {
+		return err
+	}
+	req.SetBasicAuth(c.username, c.password)
+	req.Header.Set("Content-Type", "text/plain")
+
+	_, err = c.doStorageRequest(req, name, out)
+	return err
+}
+
+// deleteStorageFile deletes a storage file, treating a missing file as already deleted.
+func (c *HAProxyClient) deleteStorageFile(ctx context.Context, path, name string) error {
+	req, err := c.newRequest(ctx, httpMethodDELETE, path, nil)
+	if err != nil {
+		return err
+	}
 
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		return nil, err
+		return err
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode == http.StatusNotFound {
-		return []StickRulePayload{}, nil // No stick rules found is not an error
+		return nil
 	}
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("storage deletion of %s failed with status %d: %s", name, resp.StatusCode, string(body))
+	}
+	return nil
+}
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+// ReadMapEntry reads a key of a map loaded in the running process, or nil if it does not exist.
+func (c *HAProxyClient) ReadMapEntry(ctx context.Context, mapName, key string) (*MapEntryPayload, error) {
+	var entry MapEntryPayload
+	found, err := c.getJSON(ctx, c.mapEntriesURL(mapName, key, false), &entry)
+	if err != nil || !found {
+		return nil, err
 	}
+	return &entry, nil
+}
 
-	var stickRulesWrapper struct {
-		Data []StickRulePayload `json:"data"`
+// CreateMapEntry adds a key to a map of the running process. Runtime requests do not use a
+// transaction and do not reload HAProxy; force_sync also writes the key to the map file so
+// that it survives a reload.
+func (c *HAProxyClient) CreateMapEntry(ctx context.Context, mapName string, payload *MapEntryPayload) error {
+	return c.sendInTransaction(ctx, httpMethodPOST, c.mapEntriesURL(mapName, "", true), payload, "map entry creation")
+}
+
+// UpdateMapEntry sets the value of a key of a map of the running process.
+func (c *HAProxyClient) UpdateMapEntry(ctx context.Context, mapName string, payload *MapEntryPayload) error {
+	body := &MapEntryPayload{Value: payload.Value}
+	return c.sendInTransaction(ctx, httpMethodPUT, c.mapEntriesURL(mapName, payload.Key, true), body, "map entry update")
+}
+
+// DeleteMapEntry removes a key from a map of the running process.
+func (c *HAProxyClient) DeleteMapEntry(ctx context.Context, mapName, key string) error {
+	return c.sendInTransaction(ctx, httpMethodDELETE, c.mapEntriesURL(mapName, key, true), nil, "map entry deletion")
+}
+
+// ReadRuntimeServers reads the state of the servers of a backend in the running process.
+func (c *HAProxyClient) ReadRuntimeServers(ctx context.Context, backend string) ([]RuntimeServerPayload, error) {
+	servers := []RuntimeServerPayload{}
+	if _, err := c.getJSON(ctx, c.runtimeServersURL(backend, ""), &servers); err != nil {
+		return nil, err
 	}
-	if err := json.NewDecoder(resp.Body).Decode(&stickRulesWrapper); err != nil {
+	return servers, nil
+}
+
+// ReadRuntimeServer reads the state of a server in the running process, or nil if it does not exist.
+func (c *HAProxyClient) ReadRuntimeServer(ctx context.Context, backend, name string) (*RuntimeServerPayload, error) {
+	var server RuntimeServerPayload
+	found, err := c.getJSON(ctx, c.runtimeServersURL(backend, name), &server)
+	if err != nil || !found {
 		return nil, err
 	}
+	return &server, nil
+}
 
-	return stickRulesWrapper.Data, nil
+// UpdateRuntimeServer changes the state of a server in the running process. Runtime requests
+// do not use a transaction and do not reload HAProxy, so the change is lost on the next reload.
+func (c *HAProxyClient) UpdateRuntimeServer(ctx context.Context, backend, name string, payload *RuntimeServerPayload) error {
+	return c.sendInTransaction(ctx, httpMethodPUT, c.runtimeServersURL(backend, name), payload, "runtime server update")
 }
 
-// UpdateStickRule updates a stick_rule.
-func (c *HAProxyClient) UpdateStickRule(ctx context.Context, index int64, backend string, payload *StickRulePayload) error {
-	resp, err := c.Transaction(func(transactionID string) (*http.Response, error) {
-		req, err := c.newRequest(ctx, "PUT", fmt.Sprintf("/services/haproxy/configuration/stick_rules/%d?backend=%s&transaction_id=%s", index, backend, transactionID), payload)
-		if err != nil {
-			return nil, err
-		}
-		return c.httpClient.Do(req)
-	})
-	if resp != nil {
-		defer resp.Body.Close()
+// ReadInfo reads the description of the Data Plane API. It returns nil when the API does
+// not serve the client API version, and an error naming the cause when the API cannot be
+// reached or rejects the credentials.
+func (c *HAProxyClient) ReadInfo(ctx context.Context) (*InfoPayload, error) {
+	req, err := c.newRequest(ctx, httpMethodGET, "/info", nil)
+	if err != nil {
+		return nil, err
 	}
-	return err
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("the Data Plane API at %s is unreachable: %w", c.baseURL, err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return nil, nil
+	case resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden:
+		return nil, fmt.Errorf("the Data Plane API at %s rejected the credentials of user %s (status %d)", c.baseURL, c.username, resp.StatusCode)
+	case resp.StatusCode != http.StatusOK:
+		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode, string(body))
+	}
+
+	var info InfoPayload
+	if err := json.Unmarshal(body, &info); err != nil {
+		return nil, fmt.Errorf("the response of %s/%s/info is not a Data Plane API description: %w", c.baseURL, c.apiVersion, err)
+	}
+	return &info, nil
 }
 
-// DeleteStickRule deletes a stick_rule.
+// ReadProcessInfo reads the description of the running HAProxy process. v2 returns one entry
+// per runtime API socket and v3 a single one; the first entry without error is returned.
+func (c *HAProxyClient) ReadProcessInfo(ctx context.Context) (*ProcessInfoPayload, error) {
+	var raw json.RawMessage
+	if _, err := c.getJSON(ctx, "/services/haproxy/runtime/info", &raw); err != nil {
+		return nil, err
+	}
+
+	var processes []ProcessInfoPayload
+	trimmed := bytes.TrimSpace(raw)
+	if len(trimmed) > 0 && trimmed[0] == '[' {
+		if err := json.Unmarshal(trimmed, &processes); err != nil {
+			return nil, err
+		}
+	} else if len(trimmed) > 0 {
+		var process ProcessInfoPayload
+		if err := json.Unmarshal(trimmed, &process); err != nil {
+			return nil, err
+		}
+		processes = append(processes, process)
+	}
+
+	for i := range processes {
+		if processes[i].Error == "" {
+			return &processes[i], nil
+		}
+	}
+	if len(processes) > 0 {
+		return nil, fmt.Errorf("process info of %s: %s", processes[0].RuntimeAPI, processes[0].Error)
+	}
+	return nil, fmt.Errorf("no HAProxy process info returned")
+}
+
+// ReadNativeStats reads the statistics of the running process, filtered by statType (frontend,
+// backend or server), name and, for servers, the parent backend. Empty filters are not sent.
+func (c *HAProxyClient) ReadNativeStats(ctx context.Context, statType, name, parent string) ([]NativeStatPayload, error) {
+	query := url.Values{}
+	if statType != "" {
+		query.Set("type", statType)
+	}
+	if name != "" {
+		query.Set("name", name)
+	}
+	if parent != "" {
+		query.Set("parent", parent)
+	}
+	endpoint := "/services/haproxy/stats/native"
+	if len(query) > 0 {
+		endpoint += "?" + query.Encode()
+	}
+
+	var raw json.RawMessage
+	if _, err := c.getJSON(ctx, endpoint, &raw); err != nil {
+		return nil, err
+	}
+
+	// v2 returns one collection per runtime API socket, v3 a single collection
+	var collections []NativeStatsCollectionPayload
+	trimmed := bytes.TrimSpace(raw)
+	if len(trimmed) > 0 && trimmed[0] == '[' {
+		if err := json.Unmarshal(trimmed, &collections); err != nil {
+			return nil, err
+		}
+	} else if len(trimmed) > 0 {
+		var collection NativeStatsCollectionPayload
+		if err := json.Unmarshal(trimmed, &collection); err != nil {
+			return nil, err
+		}
+		collections = append(collections, collection)
+	}
+
+	var stats []NativeStatPayload
+	for _, collection := range collections {
+		if collection.Error != "" {
+			return nil, fmt.Errorf("stats of %s: %s", collection.RuntimeAPI, collection.Error)
+		}
+		stats = append(stats, collection.Stats...)
+	}
+	return stats, nil
+}
+
+// runtimeServersURL builds the runtime servers URL of backend, or of one of its servers when
+// name is set.
+func (c *HAProxyClient) runtimeServersURL(backend, name string) string {
+	if c.apiVersion == "v3" {
+		endpoint := fmt.Sprintf("/services/haproxy/runtime/backends/%s/servers", backend)
+		if name != "" {
+			endpoint += "/" + name
+		}
+		return endpoint
+	}
+	endpoint := "/services/haproxy/runtime/servers"
+	if name != "" {
+		endpoint += "/" + name
+	}
+	return endpoint + "?backend=" + url.QueryEscape(backend)
+}
+
+// mapEntriesURL builds the runtime map entries URL of mapName, or of one of its keys when key
+// is set. v3 nests entries under the map while v2 passes the map as a query parameter.
+func (c *HAProxyClient) mapEntriesURL(mapName, key string, forceSync bool) string {
+	var endpoint string
+	if c.apiVersion == "v3" {
+		endpoint = fmt.Sprintf("/services/haproxy/runtime/maps/%s/entries", mapName)
+		if key != "" {
+			endpoint += "/" + url.PathEscape(key)
+		}
+		endpoint += "?"
+	} else {
+		endpoint = "/services/haproxy/runtime/maps_entries"
+		if key != "" {
+			endpoint += "/" + url.PathEscape(key)
+		}
+		endpoint += "?map=" + url.QueryEscape(mapName) + "&"
+	}
+	if forceSync {
+		endpoint += "force_sync=true"
+	}
+	return strings.TrimRight(endpoint, "?&")
+}
+
+// ReadMailers reads a mailers section.
+func (c *HAProxyClient) ReadMailers(ctx context.Context, name string) (*MailersPayload, error) {
+	var mailers MailersPayload
+	found, err := c.getJSON(ctx, fmt.Sprintf("/services/haproxy/configuration/mailers_section/%s", name), &mailers)
+	if err != nil || !found {
+		return nil, err
+	}
+	return &mailers, nil
+}
+
+// CreateMailersInTransaction creates a new mailers section using an existing transaction ID.
+func (c *HAProxyClient) CreateMailersInTransaction(ctx context.Context, transactionID string, payload *MailersPayload) error {
+	url := fmt.Sprintf("/services/haproxy/configuration/mailers_section?transaction_id=%s", transactionID)
+	return c.sendInTransaction(ctx, "POST", url, payload, "mailers creation")
+}
+
+// UpdateMailersInTransaction updates a mailers section using an existing transaction ID.
+func (c *HAProxyClient) UpdateMailersInTransaction(ctx context.Context, transactionID string, payload *MailersPayload) error {
+	url := fmt.Sprintf("/services/haproxy/configuration/mailers_section/%s?transaction_id=%s", payload.Name, transactionID)
+	return c.sendInTransaction(ctx, "PUT", url, payload, "mailers update")
+}
+
+// DeleteMailersInTransaction deletes a mailers section using an existing transaction ID.
+func (c *HAProxyClient) DeleteMailersInTransaction(ctx context.Context, transactionID, name string) error {
+	url := fmt.Sprintf("/services/haproxy/configuration/mailers_section/%s?transaction_id=%s", name, transactionID)
+	return c.sendInTransaction(ctx, "DELETE", url, nil, "mailers deletion")
+}
+
+// ReadMailerEntries reads all mailers of a mailers section.
+func (c *HAProxyClient) ReadMailerEntries(ctx context.Context, mailers string) ([]MailerEntryPayload, error) {
+	entries := []MailerEntryPayload{}
+	// No mailer entries found is not an error
+	if _, err := c.getJSON(ctx, c.mailerEntriesURL(mailers, "", ""), &entries); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// CreateMailerEntryInTransaction creates a new mailer entry using an existing transaction ID.
+func (c *HAProxyClient) CreateMailerEntryInTransaction(ctx context.Context, transactionID, mailers string, payload *MailerEntryPayload) error {
+	return c.sendInTransaction(ctx, "POST", c.mailerEntriesURL(mailers, "", transactionID), payload, "mailer entry creation")
+}
+
+// UpdateMailerEntryInTransaction updates a mailer entry using an existing transaction ID.
+func (c *HAProxyClient) UpdateMailerEntryInTransaction(ctx context.Context, transactionID, mailers string, payload *MailerEntryPayload) error {
+	return c.sendInTransaction(ctx, "PUT", c.mailerEntriesURL(mailers, payload.Name, transactionID), payload, "mailer entry update")
+}
+
+// DeleteMailerEntryInTransaction deletes a mailer entry using an existing transaction ID.
+func (c *HAProxyClient) DeleteMailerEntryInTransaction(ctx context.Context, transactionID, mailers, name string) error {
+	return c.sendInTransaction(ctx, "DELETE", c.mailerEntriesURL(mailers, name, transactionID), nil, "mailer entry deletion")
+}
+
+// mailerEntriesURL builds the mailer entry endpoint for a mailers section; name and transactionID are optional.
+func (c *HAProxyClient) mailerEntriesURL(mailers, name, transactionID string) string {
+	var url string
+	if c.apiVersion == "v3" {
+		// v3: nested under the mailers section
+		url = fmt.Sprintf("/services/haproxy/configuration/mailers_section/%s/mailer_entries", mailers)
+		if name != "" {
+			url += "/" + name
+		}
+		if transactionID != "" {
+			url += "?transaction_id=" + transactionID
+		}
+		return url
+	}
+
+	// v2: mailers section passed as a query parameter
+	url = "/services/haproxy/configuration/mailer_entries"
+	if name != "" {
+		url += "/" + name
+	}
+	url += "?mailers_section=" + mailers
+	if transactionID != "" {
+		url += "&transaction_id=" + transactionID
+	}
+	return url
+}
+
+// ReadUserlist reads a userlist.
+func (c *HAProxyClient) ReadUserlist(ctx context.Context, name string) (*UserlistPayload, error) {
+	var userlist UserlistPayload
+	found, err := c.getJSON(ctx, fmt.Sprintf("/services/haproxy/configuration/userlists/%s", name), &userlist)
+	if err != nil || !found {
+		return nil, err
+	}
+	return &userlist, nil
+}
+
+// CreateUserlistInTransaction creates a new userlist using an existing transaction ID.
+func (c *HAProxyClient) CreateUserlistInTransaction(ctx context.Context, transactionID string, payload *UserlistPayload) error {
+	url := fmt.Sprintf("/services/haproxy/configuration/userlists?transaction_id=%s", transactionID)
+	return c.sendInTransaction(ctx, "POST", url, payload, "userlist creation")
+}
+
+// DeleteUserlistInTransaction deletes a userlist using an existing transaction ID.
+func (c *HAProxyClient) DeleteUserlistInTransaction(ctx context.Context, transactionID, name string) error {
+	url := fmt.Sprintf("/services/haproxy/configuration/userlists/%s?transaction_id=%s", name, transactionID)
+	return c.sendInTransaction(ctx, "DELETE", url, nil, "userlist deletion")
+}
+
+// ReadUsers reads all users of a userlist.
+func (c *HAProxyClient) ReadUsers(ctx context.Context, userlist string) ([]UserPayload, error) {
+	users := []UserPayload{}
+	// No users found is not an error
+	if _, err := c.getJSON(ctx, c.userlistChildURL(userlist, "users", "", ""), &users); err != nil {
+		return nil, err
+	}
+	return users, nil
+}
+
+// CreateUserInTransaction creates a new user using an existing transaction ID.
+func (c *HAProxyClient) CreateUserInTransaction(ctx context.Context, transactionID, userlist string, payload *UserPayload) error {
+	return c.sendInTransaction(ctx, "POST", c.userlistChildURL(userlist, "users", "", transactionID), payload, "user creation")
+}
+
+// UpdateUserInTransaction updates a user using an existing transaction ID.
+func (c *HAProxyClient) UpdateUserInTransaction(ctx context.Context, transactionID, userlist string, payload *UserPayload) error {
+	return c.sendInTransaction(ctx, "PUT", c.userlistChildURL(userlist, "users", payload.Username, transactionID), payload, "user update")
+}
+
+// DeleteUserInTransaction deletes a user using an existing transaction ID.
+func (c *HAProxyClient) DeleteUserInTransaction(ctx context.Context, transactionID, userlist, username string) error {
+	return c.sendInTransaction(ctx, "DELETE", c.userlistChildURL(userlist, "users", username, transactionID), nil, "user deletion")
+}
+
+// ReadGroups reads all groups of a userlist.
+func (c *HAProxyClient) ReadGroups(ctx context.Context, userlist string) ([]GroupPayload, error) {
+	groups := []GroupPayload{}
+	// No groups found is not an error
+	if _, err := c.getJSON(ctx, c.userlistChildURL(userlist, "groups", "", ""), &groups); err != nil {
+		return nil, err
+	}
+	return groups, nil
+}
+
+// CreateGroupInTransaction creates a new group using an existing transaction ID.
+func (c *HAProxyClient) CreateGroupInTransaction(ctx context.Context, transactionID, userlist string, payload *GroupPayload) error {
+	return c.sendInTransaction(ctx, "POST", c.userlistChildURL(userlist, "groups", "", transactionID), payload, "group creation")
+}
+
+// UpdateGroupInTransaction updates a group using an existing transaction ID.
+func (c *HAProxyClient) UpdateGroupInTransaction(ctx context.Context, transactionID, userlist string, payload *GroupPayload) error {
+	return c.sendInTransaction(ctx, "PUT", c.userlistChildURL(userlist, "groups", payload.Name, transactionID), payload, "group update")
+}
+
+// DeleteGroupInTransaction deletes a group using an existing transaction ID.
+func (c *HAProxyClient) DeleteGroupInTransaction(ctx context.Context, transactionID, userlist, name string) error {
+	return c.sendInTransaction(ctx, "DELETE", c.userlistChildURL(userlist, "groups", name, transactionID), nil, "group deletion")
+}
+
+// userlistChildURL builds the users or groups endpoint of a userlist; name and transactionID
+// are optional.
+func (c *HAProxyClient) userlistChildURL(userlist, collection, name, transactionID string) string {
+	var url string
+	if c.apiVersion == "v3" {
+		// v3: nested under the userlist section
+		url = fmt.Sprintf("/services/haproxy/configuration/userlists/%s/%s", userlist, collection)
+		if name != "" {
+			url += "/" + name
+		}
+		if transactionID != "" {
+			url += "?transaction_id=" + transactionID
+		}
+		return url
+	}
+
+	// v2: userlist passed as a query parameter
+	url = "/services/haproxy/configuration/" + collection
+	if name != "" {
+		url += "/" + name
+	}
+	url += "?userlist=" + userlist
+	if transactionID != "" {
+		url += "&transaction_id=" + transactionID
+	}
+	return url
+}
+
+// CreateStickRule creates a new stick_rule.
+func (c *HAProxyClient) CreateStickRule(ctx context.Context, backend string, payload *StickRulePayload) error {
+	resp, err := c.Transaction(func(transactionID string) (*http.Response, error) {
+		req, err := c.newRequest(ctx, "POST", fmt.Sprintf("/services/haproxy/configuration/stick_rules?backend=%s&transaction_id=%s", backend, transactionID), payload)
+		if err != nil {
+			return nil, err
+		}
+		return c.httpClient.Do(req)
+	})
+	if resp != nil {
+		defer resp.Body.Close()
+	}
+	return err
+}
+
+// ReadStickRule reads a stick_rule.
+func (c *HAProxyClient) ReadStickRule(ctx context.Context, index int64, backend string) (*StickRulePayload, error) {
+	req, err := c.newRequest(ctx, "GET", fmt.Sprintf("/services/haproxy/configuration/stick_rules/%d?backend=%s", index, backend), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+
+	var stickRuleWrapper struct {
+		Data StickRulePayload `json:"data"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&stickRuleWrapper); err != nil {
+		return nil, err
+	}
+
+	return &stickRuleWrapper.Data, nil
+}
+
+// ReadStickRules reads all stick rules for a backend.
+func (c *HAProxyClient) ReadStickRules(ctx context.Context, backend string) ([]StickRulePayload, error) {
+	rules := []StickRulePayload{}
+	// No stick rules found is not an error
+	if _, err := c.getJSON(ctx, c.stickRulesURL(backend, nil, ""), &rules); err != nil {
+		return nil, err
+	}
+	return rules, nil
+}
+
+// UpdateStickRule updates a stick_rule.
+func (c *HAProxyClient) UpdateStickRule(ctx context.Context, index int64, backend string, payload *StickRulePayload) error {
+	resp, err := c.Transaction(func(transactionID string) (*http.Response, error) {
+		req, err := c.newRequest(ctx, "PUT", fmt.Sprintf("/services/haproxy/configuration/stick_rules/%d?backend=%s&transaction_id=%s", index, backend, transactionID), payload)
+		if err != nil {
+			return nil, err
+		}
+		return c.httpClient.Do(req)
+	})
+	if resp != nil {
+		defer resp.Body.Close()
+	}
+	return err
+}
+
+// DeleteStickRule deletes a stick_rule.
 func (c *HAProxyClient) DeleteStickRule(ctx context.Context, index int64, backend string) error {
 	resp, err := c.Transaction(func(transactionID string) (*http.Response, error) {
 		req, err := c.newRequest(ctx, "DELETE", fmt.Sprintf("/services/haproxy/configuration/stick_rules/%d?backend=%s&transaction_id=%s", index, backend, transactionID), nil)
 		if err != nil {
 			return nil, err
 		}
-		return c.httpClient.Do(req)
-	})
-	if resp != nil {
-		defer resp.Body.Close()
+		return c.httpClient.Do(req)
+	})
+	if resp != nil {
+		defer resp.Body.Close()
+	}
+	return err
+}
+
+// CreateAllStickRulesInTransaction creates all stick rules of a backend using an existing transaction ID.
+func (c *HAProxyClient) CreateAllStickRulesInTransaction(ctx context.Context, transactionID, backend string, payloads []StickRulePayload) error {
+	if c.apiVersion == "v3" {
+		// v3: replace the whole list in one request
+		return c.sendInTransaction(ctx, httpMethodPUT, c.stickRulesURL(backend, nil, transactionID), payloads, "stick rules creation")
+	}
+
+	// v2: no bulk endpoint, create the rules one by one
+	for _, payload := range payloads {
+		payload := payload
+		if err := c.sendInTransaction(ctx, httpMethodPOST, c.stickRulesURL(backend, nil, transactionID), &payload, "stick rule creation"); err != nil {
+			return fmt.Errorf("stick rule %d: %w", payload.Index, err)
+		}
+	}
+	return nil
+}
+
+// DeleteStickRuleInTransaction deletes a stick rule using an existing transaction ID.
+func (c *HAProxyClient) DeleteStickRuleInTransaction(ctx context.Context, transactionID string, index int64, backend string) error {
+	return c.sendInTransaction(ctx, "DELETE", c.stickRulesURL(backend, &index, transactionID), nil, "stick rule deletion")
+}
+
+// stickRulesURL builds the stick rule endpoint for a backend; index and transactionID are optional.
+func (c *HAProxyClient) stickRulesURL(backend string, index *int64, transactionID string) string {
+	var url string
+	if c.apiVersion == "v3" {
+		// v3: nested under the backend
+		url = fmt.Sprintf("/services/haproxy/configuration/backends/%s/stick_rules", backend)
+		if index != nil {
+			url += fmt.Sprintf("/%d", *index)
+		}
+		if transactionID != "" {
+			url += "?transaction_id=" + transactionID
+		}
+		return url
+	}
+
+	// v2: backend passed as a query parameter
+	url = "/services/haproxy/configuration/stick_rules"
+	if index != nil {
+		url += fmt.Sprintf("/%d", *index)
+	}
+	url += "?backend=" + backend
+	if transactionID != "" {
+		url += "&transaction_id=" + transactionID
+	}
+	return url
+}
+
+// ReadBackendSwitchingRules reads all backend switching rules of a frontend.
+func (c *HAProxyClient) ReadBackendSwitchingRules(ctx context.Context, frontend string) ([]BackendSwitchingRulePayload, error) {
+	rules := []BackendSwitchingRulePayload{}
+	// No backend switching rules found is not an error
+	if _, err := c.getJSON(ctx, c.backendSwitchingRulesURL(frontend, nil, ""), &rules); err != nil {
+		return nil, err
+	}
+	return rules, nil
+}
+
+// CreateAllBackendSwitchingRulesInTransaction creates all backend switching rules of a frontend using an existing transaction ID.
+func (c *HAProxyClient) CreateAllBackendSwitchingRulesInTransaction(ctx context.Context, transactionID, frontend string, payloads []BackendSwitchingRulePayload) error {
+	if c.apiVersion == "v3" {
+		// v3: replace the whole list in one request
+		return c.sendInTransaction(ctx, httpMethodPUT, c.backendSwitchingRulesURL(frontend, nil, transactionID), payloads, "backend switching rules creation")
+	}
+
+	// v2: no bulk endpoint, create the rules one by one
+	for _, payload := range payloads {
+		payload := payload
+		if err := c.sendInTransaction(ctx, httpMethodPOST, c.backendSwitchingRulesURL(frontend, nil, transactionID), &payload, "backend switching rule creation"); err != nil {
+			return fmt.Errorf("backend switching rule %d: %w", payload.Index, err)
+		}
+	}
+	return nil
+}
+
+// DeleteBackendSwitchingRuleInTransaction deletes a backend switching rule using an existing transaction ID.
+func (c *HAProxyClient) DeleteBackendSwitchingRuleInTransaction(ctx context.Context, transactionID string, index int64, frontend string) error {
+	return c.sendInTransaction(ctx, "DELETE", c.backendSwitchingRulesURL(frontend, &index, transactionID), nil, "backend switching rule deletion")
+}
+
+// backendSwitchingRulesURL builds the backend switching rule endpoint for a frontend; index and transactionID are optional.
+func (c *HAProxyClient) backendSwitchingRulesURL(frontend string, index *int64, transactionID string) string {
+	var url string
+	if c.apiVersion == "v3" {
+		// v3: nested under the frontend
+		url = fmt.Sprintf("/services/haproxy/configuration/frontends/%s/backend_switching_rules", frontend)
+		if index != nil {
+			url += fmt.Sprintf("/%d", *index)
+		}
+		if transactionID != "" {
+			url += "?transaction_id=" + transactionID
+		}
+		return url
+	}
+
+	// v2: frontend passed as a query parameter
+	url = "/services/haproxy/configuration/backend_switching_rules"
+	if index != nil {
+		url += fmt.Sprintf("/%d", *index)
+	}
+	url += "?frontend=" + frontend
+	if transactionID != "" {
+		url += "&transaction_id=" + transactionID
+	}
+	return url
+}
+
+// ReadServerSwitchingRules reads all server switching rules of a backend.
+func (c *HAProxyClient) ReadServerSwitchingRules(ctx context.Context, backend string) ([]ServerSwitchingRulePayload, error) {
+	rules := []ServerSwitchingRulePayload{}
+	// No server switching rules found is not an error
+	if _, err := c.getJSON(ctx, c.serverSwitchingRulesURL(backend, nil, ""), &rules); err != nil {
+		return nil, err
+	}
+	return rules, nil
+}
+
+// CreateAllServerSwitchingRulesInTransaction creates all server switching rules of a backend using an existing transaction ID.
+func (c *HAProxyClient) CreateAllServerSwitchingRulesInTransaction(ctx context.Context, transactionID, backend string, payloads []ServerSwitchingRulePayload) error {
+	if c.apiVersion == "v3" {
+		// v3: replace the whole list in one request
+		return c.sendInTransaction(ctx, httpMethodPUT, c.serverSwitchingRulesURL(backend, nil, transactionID), payloads, "server switching rules creation")
+	}
+
+	// v2: no bulk endpoint, create the rules one by one
+	for _, payload := range payloads {
+		payload := payload
+		if err := c.sendInTransaction(ctx, httpMethodPOST, c.serverSwitchingRulesURL(backend, nil, transactionID), &payload, "server switching rule creation"); err != nil {
+			return fmt.Errorf("server switching rule %d: %w", payload.Index, err)
+		}
+	}
+	return nil
+}
+
+// DeleteServerSwitchingRuleInTransaction deletes a server switching rule using an existing transaction ID.
+func (c *HAProxyClient) DeleteServerSwitchingRuleInTransaction(ctx context.Context, transactionID string, index int64, backend string) error {
+	return c.sendInTransaction(ctx, "DELETE", c.serverSwitchingRulesURL(backend, &index, transactionID), nil, "server switching rule deletion")
+}
+
+// serverSwitchingRulesURL builds the server switching rule endpoint for a backend; index and transactionID are optional.
+func (c *HAProxyClient) serverSwitchingRulesURL(backend string, index *int64, transactionID string) string {
+	var url string
+	if c.apiVersion == "v3" {
+		// v3: nested under the backend
+		url = fmt.Sprintf("/services/haproxy/configuration/backends/%s/server_switching_rules", backend)
+		if index != nil {
+			url += fmt.Sprintf("/%d", *index)
+		}
+		if transactionID != "" {
+			url += "?transaction_id=" + transactionID
+		}
+		return url
+	}
+
+	// v2: backend passed as a query parameter
+	url = "/services/haproxy/configuration/server_switching_rules"
+	if index != nil {
+		url += fmt.Sprintf("/%d", *index)
+	}
+	url += "?backend=" + backend
+	if transactionID != "" {
+		url += "&transaction_id=" + transactionID
+	}
+	return url
+}
+
+// ReadHttpAfterResponseRules reads all http-after-response rules of a frontend or backend.
+func (c *HAProxyClient) ReadHttpAfterResponseRules(ctx context.Context, parentType, parentName string) ([]HttpAfterResponseRulePayload, error) {
+	rules := []HttpAfterResponseRulePayload{}
+	// No http-after-response rules found is not an error
+	if _, err := c.getJSON(ctx, c.httpAfterResponseRulesURL(parentType, parentName, nil, ""), &rules); err != nil {
+		return nil, err
+	}
+	return rules, nil
+}
+
+// CreateAllHttpAfterResponseRulesInTransaction creates all http-after-response rules of a frontend or backend using an existing transaction ID.
+func (c *HAProxyClient) CreateAllHttpAfterResponseRulesInTransaction(ctx context.Context, transactionID, parentType, parentName string, payloads []HttpAfterResponseRulePayload) error {
+	if c.apiVersion == "v3" {
+		// v3: replace the whole list in one request
+		return c.sendInTransaction(ctx, httpMethodPUT, c.httpAfterResponseRulesURL(parentType, parentName, nil, transactionID), payloads, "http-after-response rules creation")
+	}
+
+	// v2: no bulk endpoint, create the rules one by one
+	for _, payload := range payloads {
+		payload := payload
+		if err := c.sendInTransaction(ctx, httpMethodPOST, c.httpAfterResponseRulesURL(parentType, parentName, nil, transactionID), &payload, "http-after-response rule creation"); err != nil {
+			return fmt.Errorf("http-after-response rule %d: %w", payload.Index, err)
+		}
+	}
+	return nil
+}
+
+// DeleteHttpAfterResponseRuleInTransaction deletes an http-after-response rule using an existing transaction ID.
+func (c *HAProxyClient) DeleteHttpAfterResponseRuleInTransaction(ctx context.Context, transactionID string, index int64, parentType, parentName string) error {
+	return c.sendInTransaction(ctx, "DELETE", c.httpAfterResponseRulesURL(parentType, parentName, &index, transactionID), nil, "http-after-response rule deletion")
+}
+
+// httpAfterResponseRulesURL builds the http-after-response rule endpoint for a parent; index and transactionID are optional.
+func (c *HAProxyClient) httpAfterResponseRulesURL(parentType, parentName string, index *int64, transactionID string) string {
+	var url string
+	if c.apiVersion == "v3" {
+		// v3: nested under the parent
+		url = fmt.Sprintf("/services/haproxy/configuration/%s/%s/http_after_response_rules", pluralParentType(parentType), parentName)
+		if index != nil {
+			url += fmt.Sprintf("/%d", *index)
+		}
+		if transactionID != "" {
+			url += "?transaction_id=" + transactionID
+		}
+		return url
+	}
+
+	// v2: parent passed as query parameters
+	url = "/services/haproxy/configuration/http_after_response_rules"
+	if index != nil {
+		url += fmt.Sprintf("/%d", *index)
+	}
+	url += fmt.Sprintf("?parent_type=%s&parent_name=%s", parentType, parentName)
+	if transactionID != "" {
+		url += "&transaction_id=" + transactionID
+	}
+	return url
+}
+
+// ReadFilters reads all filters of a frontend or backend.
+func (c *HAProxyClient) ReadFilters(ctx context.Context, parentType, parentName string) ([]FilterPayload, error) {
+	filters := []FilterPayload{}
+	// No filters found is not an error
+	if _, err := c.getJSON(ctx, c.filtersURL(parentType, parentName, nil, ""), &filters); err != nil {
+		return nil, err
+	}
+	return filters, nil
+}
+
+// CreateAllFiltersInTransaction creates all filters of a frontend or backend using an existing transaction ID.
+func (c *HAProxyClient) CreateAllFiltersInTransaction(ctx context.Context, transactionID, parentType, parentName string, payloads []FilterPayload) error {
+	if c.apiVersion == "v3" {
+		// v3: replace the whole list in one request
+		return c.sendInTransaction(ctx, httpMethodPUT, c.filtersURL(parentType, parentName, nil, transactionID), payloads, "filters creation")
+	}
+
+	// v2: no bulk endpoint, create the filters one by one
+	for _, payload := range payloads {
+		payload := payload
+		if err := c.sendInTransaction(ctx, httpMethodPOST, c.filtersURL(parentType, parentName, nil, transactionID), &payload, "filter creation"); err != nil {
+			return fmt.Errorf("filter %d: %w", payload.Index, err)
+		}
+	}
+	return nil
+}
+
+// DeleteFilterInTransaction deletes a filter using an existing transaction ID.
+func (c *HAProxyClient) DeleteFilterInTransaction(ctx context.Context, transactionID string, index int64, parentType, parentName string) error {
+	return c.sendInTransaction(ctx, "DELETE", c.filtersURL(parentType, parentName, &index, transactionID), nil, "filter deletion")
+}
+
+// filtersURL builds the filter endpoint for a parent; index and transactionID are optional.
+func (c *HAProxyClient) filtersURL(parentType, parentName string, index *int64, transactionID string) string {
+	var url string
+	if c.apiVersion == "v3" {
+		// v3: nested under the parent
+		url = fmt.Sprintf("/services/haproxy/configuration/%s/%s/filters", pluralParentType(parentType), parentName)
+		if index != nil {
+			url += fmt.Sprintf("/%d", *index)
+		}
+		if transactionID != "" {
+			url += "?transaction_id=" + transactionID
+		}
+		return url
 	}
-	return err
+
+	// v2: parent passed as query parameters
+	url = "/services/haproxy/configuration/filters"
+	if index != nil {
+		url += fmt.Sprintf("/%d", *index)
+	}
+	url += fmt.Sprintf("?parent_type=%s&parent_name=%s", parentType, parentName)
+	if transactionID != "" {
+		url += "&transaction_id=" + transactionID
+	}
+	return url
 }
 
 func (c *HAProxyClient) newRequest(ctx context.Context, method, path string, body interface{}) (*http.Request, error) {
@@ -2568,6 +3559,88 @@ func (c *HAProxyClient) newRequest(ctx context.Context, method, path string, bod
 	return req, nil
 }
 
+// sendInTransaction sends a write request and turns any non-2xx response into an error
+// that includes the response body.
+func (c *HAProxyClient) sendInTransaction(ctx context.Context, method, url string, payload interface{}, what string) error {
+	req, err := c.newRequest(ctx, method, url, payload)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("%s failed with status %d: %s", what, resp.StatusCode, string(body))
+	}
+	return nil
+}
+
+// replaceAllInTransaction replaces a whole list of child objects. On v3 createAll is a
+// single PUT of the new list, so the existing items are only deleted first on v2; this
+// also means a failed v3 update never leaves the parent without its items.
+func (c *HAProxyClient) replaceAllInTransaction(deleteAll, createAll func() error) error {
+	if c.apiVersion != "v3" {
+		if err := deleteAll(); err != nil {
+			return err
+		}
+	}
+	return createAll()
+}
+
+// pluralParentType returns the v3 collection name of a parent section type. Types that
+// are already plural, such as defaults, are returned unchanged.
+func pluralParentType(parentType string) string {
+	if strings.HasSuffix(parentType, "s") {
+		return parentType
+	}
+	return parentType + "s"
+}
+
+// getJSON reads url and decodes the response into out, handling both the v3 bare body
+// and the v2 {"data": ...} wrapper. It returns false when the object does not exist.
+func (c *HAProxyClient) getJSON(ctx context.Context, url string, out interface{}) (bool, error) {
+	req, err := c.newRequest(ctx, "GET", url, nil)
+	if err != nil {
+		return false, err
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return false, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return false, nil
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return false, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return false, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode, string(body))
+	}
+
+	if c.apiVersion != "v3" {
+		var wrapper struct {
+			Data json.RawMessage `json:"data"`
+		}
+		if err := json.Unmarshal(body, &wrapper); err == nil && len(wrapper.Data) > 0 {
+			body = wrapper.Data
+		}
+	}
+	if err := json.Unmarshal(body, out); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
 // ReadBackends reads all backends.
 func (c *HAProxyClient) ReadBackends(ctx context.Context) ([]BackendPayload, error) {
 	var url string
@@ -2712,7 +3785,7 @@ func (c *HAProxyClient) ReadHttpchecks(ctx context.Context, parentType, parentNa
 	var url string
 	if c.apiVersion == "v3" {
 		// v3: Use nested endpoint under backends
-		parentTypePlural := parentType + "s"
+		parentTypePlural := pluralParentType(parentType)
 		url = fmt.Sprintf("/services/haproxy/configuration/%s/%s/http_checks", parentTypePlural, parentName)
 	} else {
 		// v2: Use query parameter approach
@@ -2806,80 +3879,43 @@ func (c *HAProxyClient) DeleteHttpcheck(ctx context.Context, index int64, parent
 	return err
 }
 
-// CreateStickTable creates a new stick_table.
-func (c *HAProxyClient) CreateStickTable(ctx context.Context, payload *StickTablePayload) error {
-	resp, err := c.Transaction(func(transactionID string) (*http.Response, error) {
-		req, err := c.newRequest(ctx, "POST", fmt.Sprintf("/services/haproxy/configuration/stick_tables?transaction_id=%s", transactionID), payload)
-		if err != nil {
-			return nil, err
-		}
-		return c.httpClient.Do(req)
-	})
-	if resp != nil {
-		defer resp.Body.Close()
-	}
-	return err
-}
-
-// ReadStickTable reads a stick_table.
+// ReadStickTable reads a stick table section. A backend that declares no stick-table is
+// returned with a nil StickTable.
 func (c *HAProxyClient) ReadStickTable(ctx context.Context, name string) (*StickTablePayload, error) {
-	req, err := c.newRequest(ctx, "GET", fmt.Sprintf("/services/haproxy/configuration/stick_tables/%s", name), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
+	var stickTable StickTablePayload
+	found, err := c.getJSON(ctx, c.stickTableURL(name, ""), &stickTable)
+	if err != nil || !found {
 		return nil, err
 	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode == http.StatusNotFound {
-		return nil, nil
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
-	}
+	return &stickTable, nil
+}
 
-	var stickTableWrapper struct {
-		Data StickTablePayload `json:"data"`
-	}
-	if err := json.NewDecoder(resp.Body).Decode(&stickTableWrapper); err != nil {
-		return nil, err
-	}
+// CreateStickTableInTransaction creates a new stick table section using an existing transaction ID.
+func (c *HAProxyClient) CreateStickTableInTransaction(ctx context.Context, transactionID string, payload *StickTablePayload) error {
+	return c.sendInTransaction(ctx, httpMethodPOST, c.stickTableURL("", transactionID), payload, "stick table creation")
+}
 
-	return &stickTableWrapper.Data, nil
+// UpdateStickTableInTransaction updates a stick table section using an existing transaction ID.
+func (c *HAProxyClient) UpdateStickTableInTransaction(ctx context.Context, transactionID string, payload *StickTablePayload) error {
+	return c.sendInTransaction(ctx, httpMethodPUT, c.stickTableURL(payload.Name, transactionID), payload, "stick table update")
 }
 
-// UpdateStickTable updates a stick_table.
-func (c *HAProxyClient) UpdateStickTable(ctx context.Context, name string, payload *StickTablePayload) error {
-	resp, err := c.Transaction(func(transactionID string) (*http.Response, error) {
-		req, err := c.newRequest(ctx, "PUT", fmt.Sprintf("/services/haproxy/configuration/stick_tables/%s?transaction_id=%s", name, transactionID), payload)
-		if err != nil {
-			return nil, err
-		}
-		return c.httpClient.Do(req)
-	})
-	if resp != nil {
-		defer resp.Body.Close()
-	}
-	return err
+// DeleteStickTableInTransaction deletes a stick table section using an existing transaction ID.
+func (c *HAProxyClient) DeleteStickTableInTransaction(ctx context.Context, transactionID, name string) error {
+	return c.sendInTransaction(ctx, "DELETE", c.stickTableURL(name, transactionID), nil, "stick table deletion")
 }
 
-// DeleteStickTable deletes a stick_table.
-func (c *HAProxyClient) DeleteStickTable(ctx context.Context, name string) error {
-	resp, err := c.Transaction(func(transactionID string) (*http.Response, error) {
-		req, err := c.newRequest(ctx, "DELETE", fmt.Sprintf("/services/haproxy/configuration/stick_tables/%s?transaction_id=%s", name, transactionID), nil)
-		if err != nil {
-			return nil, err
-		}
-		return c.httpClient.Do(req)
-	})
-	if resp != nil {
-		defer resp.Body.Close()
+// stickTableURL builds the backend endpoint that holds stick table sections; name and
+// transactionID are optional.
+func (c *HAProxyClient) stickTableURL(name, transactionID string) string {
+	url := "/services/haproxy/configuration/backends"
+	if name != "" {
+		url += "/" + name
 	}
-	return err
+	if transactionID != "" {
+		url += "?transaction_id=" + transactionID
+	}
+	return url
 }
 
 // CreateTcpCheck creates a new tcp_check.
@@ -2902,7 +3938,7 @@ func (c *HAProxyClient) ReadTcpChecks(ctx context.Context, parentType, parentNam
 	var url string
 	if c.apiVersion == "v3" {
 		// v3: Use nested endpoint under backends
-		parentTypePlural := parentType + "s"
+		parentTypePlural := pluralParentType(parentType)
 		url = fmt.Sprintf("/services/haproxy/configuration/%s/%s/tcp_checks", parentTypePlural, parentName)
 	} else {
 		// v2: Use query parameter approach
@@ -3016,7 +4052,7 @@ func (c *HAProxyClient) ReadTcpRequestRules(ctx context.Context, parentType, par
 	var url string
 	if c.apiVersion == "v3" {
 		// v3: Use nested endpoint under frontends/backends
-		parentTypePlural := parentType + "s"
+		parentTypePlural := pluralParentType(parentType)
 		url = fmt.Sprintf("/services/haproxy/configuration/%s/%s/tcp_request_rules", parentTypePlural, parentName)
 	} else {
 		// v2: Use query parameter approach
@@ -3130,7 +4166,7 @@ func (c *HAProxyClient) ReadTcpResponseRules(ctx context.Context, parentType, pa
 	var url string
 	if c.apiVersion == "v3" {
 		// v3: Use nested endpoint under backends
-		parentTypePlural := parentType + "s"
+		parentTypePlural := pluralParentType(parentType)
 		url = fmt.Sprintf("/services/haproxy/configuration/%s/%s/tcp_response_rules", parentTypePlural, parentName)
 	} else {
 		// v2: Use query parameter approach
@@ -3332,6 +4368,76 @@ func (c *HAProxyClient) DeleteLogForward(ctx context.Context, name string) error
 	return err
 }
 
+// CreateLogForwardInTransaction creates a new log_forward section using an existing transaction ID.
+func (c *HAProxyClient) CreateLogForwardInTransaction(ctx context.Context, transactionID string, payload *LogForwardPayload) error {
+	url := fmt.Sprintf("/services/haproxy/configuration/log_forwards?transaction_id=%s", transactionID)
+	return c.sendInTransaction(ctx, httpMethodPOST, url, payload, "log forward creation")
+}
+
+// UpdateLogForwardInTransaction updates a log_forward section using an existing transaction ID.
+func (c *HAProxyClient) UpdateLogForwardInTransaction(ctx context.Context, transactionID, name string, payload *LogForwardPayload) error {
+	url := fmt.Sprintf("/services/haproxy/configuration/log_forwards/%s?transaction_id=%s", name, transactionID)
+	return c.sendInTransaction(ctx, httpMethodPUT, url, payload, "log forward update")
+}
+
+// DeleteLogForwardInTransaction deletes a log_forward section using an existing transaction ID.
+func (c *HAProxyClient) DeleteLogForwardInTransaction(ctx context.Context, transactionID, name string) error {
+	url := fmt.Sprintf("/services/haproxy/configuration/log_forwards/%s?transaction_id=%s", name, transactionID)
+	return c.sendInTransaction(ctx, "DELETE", url, nil, "log forward deletion")
+}
+
+// ReadDgramBinds reads all dgram-binds of a log_forward section.
+func (c *HAProxyClient) ReadDgramBinds(ctx context.Context, logForward string) ([]DgramBindPayload, error) {
+	dgramBinds := []DgramBindPayload{}
+	// No dgram binds found is not an error
+	if _, err := c.getJSON(ctx, c.dgramBindsURL(logForward, "", ""), &dgramBinds); err != nil {
+		return nil, err
+	}
+	return dgramBinds, nil
+}
+
+// CreateDgramBindInTransaction creates a new dgram-bind using an existing transaction ID.
+func (c *HAProxyClient) CreateDgramBindInTransaction(ctx context.Context, transactionID, logForward string, payload *DgramBindPayload) error {
+	return c.sendInTransaction(ctx, httpMethodPOST, c.dgramBindsURL(logForward, "", transactionID), payload, "dgram bind creation")
+}
+
+// UpdateDgramBindInTransaction updates a dgram-bind using an existing transaction ID.
+func (c *HAProxyClient) UpdateDgramBindInTransaction(ctx context.Context, transactionID, logForward string, payload *DgramBindPayload) error {
+	return c.sendInTransaction(ctx, httpMethodPUT, c.dgramBindsURL(logForward, payload.Name, transactionID), payload, "dgram bind update")
+}
+
+// DeleteDgramBindInTransaction deletes a dgram-bind using an existing transaction ID.
+func (c *HAProxyClient) DeleteDgramBindInTransaction(ctx context.Context, transactionID, logForward, name string) error {
+	return c.sendInTransaction(ctx, "DELETE", c.dgramBindsURL(logForward, name, transactionID), nil, "dgram bind deletion")
+}
+
+// dgramBindsURL builds the dgram-bind endpoint for a log_forward section; name and transactionID are optional.
+func (c *HAProxyClient) dgramBindsURL(logForward, name, transactionID string) string {
+	var url string
+	if c.apiVersion == "v3" {
+		// v3: nested under the log_forward section
+		url = fmt.Sprintf("/services/haproxy/configuration/log_forwards/%s/dgram_binds", logForward)
+		if name != "" {
+			url += "/" + name
+		}
+		if transactionID != "" {
+			url += "?transaction_id=" + transactionID
+		}
+		return url
+	}
+
+	// v2: log_forward passed as a query parameter
+	url = "/services/haproxy/configuration/dgram_binds"
+	if name != "" {
+		url += "/" + name
+	}
+	url += "?log_forward=" + logForward
+	if transactionID != "" {
+		url += "&transaction_id=" + transactionID
+	}
+	return url
+}
+
 // CreateGlobal creates a new global.
 func (c *HAProxyClient) CreateGlobal(ctx context.Context, payload *GlobalPayload) error {
 	resp, err := c.Transaction(func(transactionID string) (*http.Response, error) {
@@ -3439,6 +4545,153 @@ func (c *HAProxyClient) DeleteGlobal(ctx context.Context) error {
 	return err
 }
 
+// ReadGlobalSection reads the global section as raw JSON, including the fields that
+// GlobalPayload does not model, so that it can be written back without losing them.
+func (c *HAProxyClient) ReadGlobalSection(ctx context.Context) (map[string]interface{}, error) {
+	section := map[string]interface{}{}
+	if _, err := c.getJSON(ctx, "/services/haproxy/configuration/global", &section); err != nil {
+		return nil, err
+	}
+	return section, nil
+}
+
+// ReplaceGlobalInTransaction replaces the global section using an existing transaction ID.
+func (c *HAProxyClient) ReplaceGlobalInTransaction(ctx context.Context, transactionID string, section map[string]interface{}) error {
+	url := fmt.Sprintf("/services/haproxy/configuration/global?transaction_id=%s", transactionID)
+	return c.sendInTransaction(ctx, httpMethodPUT, url, section, "global update")
+}
+
+// ReadLogTargets reads all log targets of a section; parentName is ignored for global.
+func (c *HAProxyClient) ReadLogTargets(ctx context.Context, parentType, parentName string) ([]LogTargetPayload, error) {
+	logTargets := []LogTargetPayload{}
+	// No log targets found is not an error
+	if _, err := c.getJSON(ctx, c.logTargetsURL(parentType, parentName, nil, ""), &logTargets); err != nil {
+		return nil, err
+	}
+	sort.Slice(logTargets, func(i, j int) bool {
+		return logTargets[i].Index < logTargets[j].Index
+	})
+	return logTargets, nil
+}
+
+// CreateAllLogTargetsInTransaction creates all log targets of a section using an existing transaction ID.
+func (c *HAProxyClient) CreateAllLogTargetsInTransaction(ctx context.Context, transactionID, parentType, parentName string, payloads []LogTargetPayload) error {
+	if c.apiVersion == "v3" {
+		// v3: replace the whole list in one request
+		return c.sendInTransaction(ctx, httpMethodPUT, c.logTargetsURL(parentType, parentName, nil, transactionID), payloads, "log targets creation")
+	}
+
+	// v2: no bulk endpoint, create the log targets one by one
+	for _, payload := range payloads {
+		payload := payload
+		if err := c.sendInTransaction(ctx, httpMethodPOST, c.logTargetsURL(parentType, parentName, nil, transactionID), &payload, "log target creation"); err != nil {
+			return fmt.Errorf("log target %d: %w", payload.Index, err)
+		}
+	}
+	return nil
+}
+
+// ReplaceLogTargetsInTransaction replaces all log targets of a section using an existing transaction ID.
+func (c *HAProxyClient) ReplaceLogTargetsInTransaction(ctx context.Context, transactionID, parentType, parentName string, payloads []LogTargetPayload) error {
+	existing, err := c.ReadLogTargets(ctx, parentType, parentName)
+	if err != nil {
+		return fmt.Errorf("failed to read %s log targets: %w", parentType, err)
+	}
+	// Delete in reverse order (highest index first) to avoid shifting issues
+	sort.Slice(existing, func(i, j int) bool {
+		return existing[i].Index > existing[j].Index
+	})
+	for _, logTarget := range existing {
+		if err := c.DeleteLogTargetInTransaction(ctx, transactionID, logTarget.Index, parentType, parentName); err != nil {
+			return err
+		}
+	}
+	if len(payloads) == 0 {
+		return nil
+	}
+	return c.CreateAllLogTargetsInTransaction(ctx, transactionID, parentType, parentName, payloads)
+}
+
+// DeleteLogTargetInTransaction deletes a log target using an existing transaction ID.
+func (c *HAProxyClient) DeleteLogTargetInTransaction(ctx context.Context, transactionID string, index int64, parentType, parentName string) error {
+	return c.sendInTransaction(ctx, "DELETE", c.logTargetsURL(parentType, parentName, &index, transactionID), nil, "log target deletion")
+}
+
+// logTargetsURL builds the log target endpoint of a section; index and transactionID are optional.
+func (c *HAProxyClient) logTargetsURL(parentType, parentName string, index *int64, transactionID string) string {
+	var url string
+	if c.apiVersion == "v3" {
+		// v3: nested under the parent section
+		if parentType == "global" {
+			url = "/services/haproxy/configuration/global/log_targets"
+		} else {
+			url = fmt.Sprintf("/services/haproxy/configuration/%s/%s/log_targets", pluralParentType(parentType), parentName)
+		}
+		if index != nil {
+			url += fmt.Sprintf("/%d", *index)
+		}
+		if transactionID != "" {
+			url += "?transaction_id=" + transactionID
+		}
+		return url
+	}
+
+	// v2: parent passed as query parameters
+	url = "/services/haproxy/configuration/log_targets"
+	if index != nil {
+		url += fmt.Sprintf("/%d", *index)
+	}
+	url += "?parent_type=" + parentType
+	if parentType != "global" {
+		url += "&parent_name=" + parentName
+	}
+	if transactionID != "" {
+		url += "&transaction_id=" + transactionID
+	}
+	return url
+}
+
+// ReadDefaults reads a named defaults section.
+func (c *HAProxyClient) ReadDefaults(ctx context.Context, name string) (*DefaultsPayload, error) {
+	var defaults DefaultsPayload
+	found, err := c.getJSON(ctx, c.defaultsURL(name, ""), &defaults)
+	if err != nil || !found {
+		return nil, err
+	}
+	return &defaults, nil
+}
+
+// CreateDefaultsInTransaction creates a new named defaults section using an existing transaction ID.
+func (c *HAProxyClient) CreateDefaultsInTransaction(ctx context.Context, transactionID string, payload *DefaultsPayload) error {
+	return c.sendInTransaction(ctx, httpMethodPOST, c.defaultsURL("", transactionID), payload, "defaults creation")
+}
+
+// UpdateDefaultsInTransaction updates a named defaults section using an existing transaction ID.
+func (c *HAProxyClient) UpdateDefaultsInTransaction(ctx context.Context, transactionID string, payload *DefaultsPayload) error {
+	return c.sendInTransaction(ctx, httpMethodPUT, c.defaultsURL(payload.Name, transactionID), payload, "defaults update")
+}
+
+// DeleteDefaultsInTransaction deletes a named defaults section using an existing transaction ID.
+func (c *HAProxyClient) DeleteDefaultsInTransaction(ctx context.Context, transactionID, name string) error {
+	return c.sendInTransaction(ctx, "DELETE", c.defaultsURL(name, transactionID), nil, "defaults deletion")
+}
+
+// defaultsURL builds the named defaults endpoint; name and transactionID are optional.
+func (c *HAProxyClient) defaultsURL(name, transactionID string) string {
+	// v3 serves named sections under defaults, v2 under named_defaults
+	url := "/services/haproxy/configuration/defaults"
+	if c.apiVersion != "v3" {
+		url = "/services/haproxy/configuration/named_defaults"
+	}
+	if name != "" {
+		url += "/" + name
+	}
+	if transactionID != "" {
+		url += "?transaction_id=" + transactionID
+	}
+	return url
+}
+
 // CreateHttpRequestRuleInTransaction creates a new httprequestrule using an existing transaction ID.
 func (c *HAProxyClient) CreateHttpRequestRuleInTransaction(ctx context.Context, transactionID, parentType, parentName string, payload *HttpRequestRulePayload) error {
 	var url string
@@ -3449,7 +4702,7 @@ func (c *HAProxyClient) CreateHttpRequestRuleInTransaction(ctx context.Context,
 		// v3: Use nested endpoint under frontends/backends
 		// v3 doesn't support POST for individual rules - only PUT to replace entire list
 		// v3 expects an array of rules, not a single rule
-		parentTypePlural := parentType + "s"
+		parentTypePlural := pluralParentType(parentType)
 		url = fmt.Sprintf("/services/haproxy/configuration/%s/%s/http_request_rules?transaction_id=%s",
 			parentTypePlural, parentName, transactionID)
 		method = httpMethodPUT
@@ -3461,9 +4714,8 @@ func (c *HAProxyClient) CreateHttpRequestRuleInTransaction(ctx context.Context,
 			return fmt.Errorf("failed to read existing HTTP request rules for v3: %w", err)
 		}
 
-		// Add the new rule to existing rules
-		allRules := append(existingRules, *payload)
-		requestPayload = allRules
+		// Insert the new rule at its requested position, matching v2 POST semantics
+		requestPayload = insertHttpRequestRuleAt(existingRules, *payload)
 	} else {
 		// v2: Use query parameter approach with POST
 		url = fmt.Sprintf("/services/haproxy/configuration/http_request_rules?parent_type=%s&parent_name=%s&transaction_id=%s",
@@ -3504,7 +4756,7 @@ func (c *HAProxyClient) CreateAllHttpRequestRulesInTransaction(ctx context.Conte
 
 	if c.apiVersion == "v3" {
 		// v3: Use nested endpoint under frontends/backends - send all at once
-		parentTypePlural := parentType + "s"
+		parentTypePlural := pluralParentType(parentType)
 		url = fmt.Sprintf("/services/haproxy/configuration/%s/%s/http_request_rules?transaction_id=%s",
 			parentTypePlural, parentName, transactionID)
 		method = httpMethodPUT
@@ -3588,7 +4840,7 @@ func (c *HAProxyClient) DeleteHttpRequestRuleInTransaction(ctx context.Context,
 	if c.apiVersion == "v3" {
 		// v3: Use nested endpoint under frontends/backends
 		// Properly pluralize the parent type
-		parentTypePlural := parentType + "s"
+		parentTypePlural := pluralParentType(parentType)
 		url = fmt.Sprintf("/services/haproxy/configuration/%s/%s/http_request_rules/%d?transaction_id=%s",
 			parentTypePlural, parentName, index, transactionID)
 	} else {
@@ -3622,6 +4874,51 @@ func (c *HAProxyClient) DeleteHttpRequestRuleInTransaction(ctx context.Context,
 	return nil
 }
 
+// UpdateHttpRequestRuleInTransaction replaces the httprequestrule at the given index using an existing transaction ID.
+func (c *HAProxyClient) UpdateHttpRequestRuleInTransaction(ctx context.Context, transactionID string, index int64, parentType, parentName string, payload *HttpRequestRulePayload) error {
+	var url string
+	if c.apiVersion == "v3" {
+		// v3: Use nested endpoint under frontends/backends
+		parentTypePlural := pluralParentType(parentType)
+		url = fmt.Sprintf("/services/haproxy/configuration/%s/%s/http_request_rules/%d?transaction_id=%s",
+			parentTypePlural, parentName, index, transactionID)
+	} else {
+		// v2: Use query parameter approach
+		url = fmt.Sprintf("/services/haproxy/configuration/http_request_rules/%d?parent_type=%s&parent_name=%s&transaction_id=%s",
+			index, parentType, parentName, transactionID)
+	}
+
+	req, err := c.newRequest(ctx, "PUT", url, payload)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusAccepted {
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("HTTP request rule update failed with status %d: %s", resp.StatusCode, string(body))
+	}
+
+	return nil
+}
+
+// insertHttpRequestRuleAt inserts rule at rule.Index, appending when the index is past the end.
+func insertHttpRequestRuleAt(rules []HttpRequestRulePayload, rule HttpRequestRulePayload) []HttpRequestRulePayload {
+	if rule.Index < 0 || rule.Index >= int64(len(rules)) {
+		rule.Index = int64(len(rules))
+		return append(rules, rule)
+	}
+	result := make([]HttpRequestRulePayload, 0, len(rules)+1)
+	result = append(result, rules[:rule.Index]...)
+	result = append(result, rule)
+	return append(result, rules[rule.Index:]...)
+}
+
 // CreateHttpResponseRuleInTransaction creates a new httpresponserule using an existing transaction ID.
 func (c *HAProxyClient) CreateHttpResponseRuleInTransaction(ctx context.Context, transactionID, parentType, parentName string, payload *HttpResponseRulePayload) error {
 	var url string
@@ -3632,7 +4929,7 @@ func (c *HAProxyClient) CreateHttpResponseRuleInTransaction(ctx context.Context,
 		// v3: Use nested endpoint under frontends/backends
 		// v3 doesn't support POST for individual rules - only PUT to replace entire list
 		// v3 expects an array of rules, not a single rule
-		parentTypePlural := parentType + "s"
+		parentTypePlural := pluralParentType(parentType)
 		url = fmt.Sprintf("/services/haproxy/configuration/%s/%s/http_response_rules?transaction_id=%s",
 			parentTypePlural, parentName, transactionID)
 		method = httpMethodPUT
@@ -3643,10 +4940,8 @@ func (c *HAProxyClient) CreateHttpResponseRuleInTransaction(ctx context.Context,
 			return fmt.Errorf("failed to read existing HTTP response rules for v3: %w", err)
 		}
 
-		// Add the new rule to the existing rules
-		payload.Index = int64(len(existingRules)) // Set index to the end
-		allRules := append(existingRules, *payload)
-		requestPayload = allRules
+		// Insert the new rule at its requested position, matching v2 POST semantics
+		requestPayload = insertHttpResponseRuleAt(existingRules, *payload)
 	} else {
 		// v2: Use query parameter approach
 		url = fmt.Sprintf("/services/haproxy/configuration/http_response_rules?parent_type=%s&parent_name=%s&transaction_id=%s",
@@ -3684,7 +4979,7 @@ func (c *HAProxyClient) CreateHttpResponseRuleInTransaction(ctx context.Context,
 func (c *HAProxyClient) CreateAllHttpResponseRulesInTransaction(ctx context.Context, transactionID, parentType, parentName string, payloads []HttpResponseRulePayload) error {
 	if c.apiVersion == "v3" {
 		// v3: Use nested endpoint under frontends/backends - send all at once
-		parentTypePlural := parentType + "s"
+		parentTypePlural := pluralParentType(parentType)
 		url := fmt.Sprintf("/services/haproxy/configuration/%s/%s/http_response_rules?transaction_id=%s",
 			parentTypePlural, parentName, transactionID)
 		method := httpMethodPUT
@@ -3768,7 +5063,7 @@ func (c *HAProxyClient) DeleteHttpResponseRuleInTransaction(ctx context.Context,
 	if c.apiVersion == "v3" {
 		// v3: Use nested endpoint under frontends/backends
 		// Properly pluralize the parent type
-		parentTypePlural := parentType + "s"
+		parentTypePlural := pluralParentType(parentType)
 		url = fmt.Sprintf("/services/haproxy/configuration/%s/%s/http_response_rules/%d?transaction_id=%s",
 			parentTypePlural, parentName, index, transactionID)
 	} else {
@@ -3802,11 +5097,56 @@ func (c *HAProxyClient) DeleteHttpResponseRuleInTransaction(ctx context.Context,
 	return nil
 }
 
+// UpdateHttpResponseRuleInTransaction replaces the httpresponserule at the given index using an existing transaction ID.
+func (c *HAProxyClient) UpdateHttpResponseRuleInTransaction(ctx context.Context, transactionID string, index int64, parentType, parentName string, payload *HttpResponseRulePayload) error {
+	var url string
+	if c.apiVersion == "v3" {
+		// v3: Use nested endpoint under frontends/backends
+		parentTypePlural := pluralParentType(parentType)
+		url = fmt.Sprintf("/services/haproxy/configuration/%s/%s/http_response_rules/%d?transaction_id=%s",
+			parentTypePlural, parentName, index, transactionID)
+	} else {
+		// v2: Use query parameter approach
+		url = fmt.Sprintf("/services/haproxy/configuration/http_response_rules/%d?parent_type=%s&parent_name=%s&transaction_id=%s",
+			index, parentType, parentName, transactionID)
+	}
+
+	req, err := c.newRequest(ctx, "PUT", url, payload)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusAccepted {
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("HTTP response rule update failed with status %d: %s", resp.StatusCode, string(body))
+	}
+
+	return nil
+}
+
+// insertHttpResponseRuleAt inserts rule at rule.Index, appending when the index is past the end.
+func insertHttpResponseRuleAt(rules []HttpResponseRulePayload, rule HttpResponseRulePayload) []HttpResponseRulePayload {
+	if rule.Index < 0 || rule.Index >= int64(len(rules)) {
+		rule.Index = int64(len(rules))
+		return append(rules, rule)
+	}
+	result := make([]HttpResponseRulePayload, 0, len(rules)+1)
+	result = append(result, rules[:rule.Index]...)
+	result = append(result, rule)
+	return append(result, rules[rule.Index:]...)
+}
+
 // CreateAllTcpRequestRulesInTransaction creates all TCP request rules at once using an existing transaction ID
 func (c *HAProxyClient) CreateAllTcpRequestRulesInTransaction(ctx context.Context, transactionID, parentType, parentName string, payloads []TcpRequestRulePayload) error {
 	if c.apiVersion == "v3" {
 		// v3: Use nested endpoint under frontends/backends - send all at once
-		parentTypePlural := parentType + "s"
+		parentTypePlural := pluralParentType(parentType)
 		url := fmt.Sprintf("/services/haproxy/configuration/%s/%s/tcp_request_rules?transaction_id=%s",
 			parentTypePlural, parentName, transactionID)
 		method := httpMethodPUT
@@ -3920,7 +5260,7 @@ func (c *HAProxyClient) DeleteTcpRequestRuleInTransaction(ctx context.Context, t
 	if c.apiVersion == "v3" {
 		// v3: Use nested endpoint under frontends/backends
 		// Properly pluralize the parent type
-		parentTypePlural := parentType + "s"
+		parentTypePlural := pluralParentType(parentType)
 		url = fmt.Sprintf("/services/haproxy/configuration/%s/%s/tcp_request_rules/%d?transaction_id=%s",
 			parentTypePlural, parentName, index, transactionID)
 	} else {
@@ -3955,7 +5295,7 @@ func (c *HAProxyClient) DeleteTcpRequestRuleInTransaction(ctx context.Context, t
 func (c *HAProxyClient) CreateAllTcpResponseRulesInTransaction(ctx context.Context, transactionID, parentType, parentName string, payloads []TcpResponseRulePayload) error {
 	if c.apiVersion == "v3" {
 		// v3: Use nested endpoint under backends - send all at once
-		parentTypePlural := parentType + "s"
+		parentTypePlural := pluralParentType(parentType)
 		url := fmt.Sprintf("/services/haproxy/configuration/%s/%s/tcp_response_rules?transaction_id=%s",
 			parentTypePlural, parentName, transactionID)
 		method := httpMethodPUT
@@ -4069,7 +5409,7 @@ func (c *HAProxyClient) DeleteTcpResponseRuleInTransaction(ctx context.Context,
 	if c.apiVersion == "v3" {
 		// v3: Use nested endpoint under backends
 		// Properly pluralize the parent type
-		parentTypePlural := parentType + "s"
+		parentTypePlural := pluralParentType(parentType)
 		url = fmt.Sprintf("/services/haproxy/configuration/%s/%s/tcp_response_rules/%d?transaction_id=%s",
 			parentTypePlural, parentName, index, transactionID)
 	} else {
@@ -4104,7 +5444,7 @@ func (c *HAProxyClient) DeleteTcpResponseRuleInTransaction(ctx context.Context,
 func (c *HAProxyClient) CreateAllHttpchecksInTransaction(ctx context.Context, transactionID, parentType, parentName string, payloads []HttpcheckPayload) error {
 	if c.apiVersion == "v3" {
 		// v3: Use nested endpoint under backends - send all at once
-		parentTypePlural := parentType + "s"
+		parentTypePlural := pluralParentType(parentType)
 		url := fmt.Sprintf("/services/haproxy/configuration/%s/%s/http_checks?transaction_id=%s",
 			parentTypePlural, parentName, transactionID)
 		method := httpMethodPUT
@@ -4176,7 +5516,7 @@ func (c *HAProxyClient) DeleteHttpcheckInTransaction(ctx context.Context, transa
 	if c.apiVersion == "v3" {
 		// v3: Use nested endpoint under backends
 		// Properly pluralize the parent type
-		parentTypePlural := parentType + "s"
+		parentTypePlural := pluralParentType(parentType)
 		url = fmt.Sprintf("/services/haproxy/configuration/%s/%s/http_checks/%d?transaction_id=%s",
 			parentTypePlural, parentName, index, transactionID)
 	} else {
@@ -4211,7 +5551,7 @@ func (c *HAProxyClient) DeleteHttpcheckInTransaction(ctx context.Context, transa
 func (c *HAProxyClient) CreateAllTcpChecksInTransaction(ctx context.Context, transactionID, parentType, parentName string, payloads []TcpCheckPayload) error {
 	if c.apiVersion == "v3" {
 		// v3: Use nested endpoint under backends - send all at once
-		parentTypePlural := parentType + "s"
+		parentTypePlural := pluralParentType(parentType)
 		url := fmt.Sprintf("/services/haproxy/configuration/%s/%s/tcp_checks?transaction_id=%s",
 			parentTypePlural, parentName, transactionID)
 		method := httpMethodPUT
@@ -4367,7 +5707,7 @@ func (c *HAProxyClient) DeleteTcpCheckInTransaction(ctx context.Context, transac
 	if c.apiVersion == "v3" {
 		// v3: Use nested endpoint under backends
 		// Properly pluralize the parent type
-		parentTypePlural := parentType + "s"
+		parentTypePlural := pluralParentType(parentType)
 		url = fmt.Sprintf("/services/haproxy/configuration/%s/%s/tcp_checks/%d?transaction_id=%s",
 			parentTypePlural, parentName, index, transactionID)
 	} else {
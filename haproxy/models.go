@@ -43,6 +43,9 @@ type FrontendPayload struct {
 	TimeoutTarpit            int64                `json:"timeout_tarpit,omitempty"`
 	StatsOptions             *StatsOptionsPayload `json:"stats_options,omitempty"`
 	MonitorFail              *MonitorFailPayload  `json:"monitor_fail,omitempty"`
+	Compression              *CompressionPayload  `json:"compression,omitempty"`
+	ErrorFiles               []ErrorFilePayload   `json:"error_files,omitempty"`
+	ErrorFilesFromHttpErrors []ErrorFilesPayload  `json:"errorfiles_from_http_errors,omitempty"`
 }
 
 // StatsOptionsPayload is the payload for the stats_options resource.
@@ -101,16 +104,117 @@ type TransactionResponse struct {
 }
 
 // GlobalPayload is the payload for the global resource.
+// Data Plane API v2 uses the flat ssl_default_* and tune_* fields and lua_loads,
+// v3 groups them under ssl_options, tune_*_options and lua_options.
 type GlobalPayload struct {
-	Name                    string `json:"name"`
-	Maxconn                 int64  `json:"maxconn,omitempty"`
-	Daemon                  string `json:"daemon,omitempty"`
-	StatsTimeout            int64  `json:"stats_timeout,omitempty"`
-	TuneSslDefaultDhParam   int64  `json:"tune_ssl_default_dh_param,omitempty"`
-	SslDefaultBindCiphers   string `json:"ssl_default_bind_ciphers,omitempty"`
-	SslDefaultBindOptions   string `json:"ssl_default_bind_options,omitempty"`
-	SslDefaultServerCiphers string `json:"ssl_default_server_ciphers,omitempty"`
-	SslDefaultServerOptions string `json:"ssl_default_server_options,omitempty"`
+	Maxconn                      int64                    `json:"maxconn,omitempty"`
+	Nbthread                     int64                    `json:"nbthread,omitempty"`
+	StatsTimeout                 int64                    `json:"stats_timeout,omitempty"`
+	TuneSslDefaultDhParam        int64                    `json:"tune_ssl_default_dh_param,omitempty"`
+	SslDefaultBindCiphers        string                   `json:"ssl_default_bind_ciphers,omitempty"`
+	SslDefaultBindCiphersuites   string                   `json:"ssl_default_bind_ciphersuites,omitempty"`
+	SslDefaultBindOptions        string                   `json:"ssl_default_bind_options,omitempty"`
+	SslDefaultServerCiphers      string                   `json:"ssl_default_server_ciphers,omitempty"`
+	SslDefaultServerCiphersuites string                   `json:"ssl_default_server_ciphersuites,omitempty"`
+	SslDefaultServerOptions      string                   `json:"ssl_default_server_options,omitempty"`
+	SslOptions                   *GlobalSslOptions        `json:"ssl_options,omitempty"`
+	TuneOptions                  *GlobalTuneOptions       `json:"tune_options,omitempty"`
+	TuneBufferOptions            *GlobalTuneBufferOptions `json:"tune_buffer_options,omitempty"`
+	TuneSslOptions               *GlobalTuneSslOptions    `json:"tune_ssl_options,omitempty"`
+	RuntimeAPIs                  []GlobalRuntimeAPI       `json:"runtime_apis,omitempty"`
+	LuaLoads                     []GlobalLuaLoad          `json:"lua_loads,omitempty"`
+	LuaOptions                   *GlobalLuaOptions        `json:"lua_options,omitempty"`
+}
+
+// GlobalSslOptions holds the ssl-default-* directives (v3).
+type GlobalSslOptions struct {
+	DefaultBindCiphers        string `json:"default_bind_ciphers,omitempty"`
+	DefaultBindCiphersuites   string `json:"default_bind_ciphersuites,omitempty"`
+	DefaultBindOptions        string `json:"default_bind_options,omitempty"`
+	DefaultServerCiphers      string `json:"default_server_ciphers,omitempty"`
+	DefaultServerCiphersuites string `json:"default_server_ciphersuites,omitempty"`
+	DefaultServerOptions      string `json:"default_server_options,omitempty"`
+}
+
+// GlobalTuneOptions holds tune.* directives. v2 keeps the buffer and SSL cache settings
+// here as well; v3 moves them to GlobalTuneBufferOptions and GlobalTuneSslOptions.
+type GlobalTuneOptions struct {
+	Bufsize      int64 `json:"bufsize,omitempty"`
+	Maxrewrite   int64 `json:"maxrewrite,omitempty"`
+	HttpMaxhdr   int64 `json:"http_maxhdr,omitempty"`
+	SslCachesize int64 `json:"ssl_cachesize,omitempty"`
+}
+
+// GlobalTuneBufferOptions holds the tune.bufsize and tune.maxrewrite directives (v3).
+type GlobalTuneBufferOptions struct {
+	Bufsize    int64 `json:"bufsize,omitempty"`
+	Maxrewrite int64 `json:"maxrewrite,omitempty"`
+}
+
+// GlobalTuneSslOptions holds the tune.ssl.* directives (v3).
+type GlobalTuneSslOptions struct {
+	Cachesize      int64 `json:"cachesize,omitempty"`
+	DefaultDhParam int64 `json:"default_dh_param,omitempty"`
+}
+
+// GlobalRuntimeAPI represents a stats socket declared in the global section.
+type GlobalRuntimeAPI struct {
+	Address           string `json:"address"`
+	Level             string `json:"level,omitempty"`
+	Mode              string `json:"mode,omitempty"`
+	ExposeFdListeners bool   `json:"expose_fd_listeners,omitempty"`
+}
+
+// GlobalLuaLoad represents a lua-load directive.
+type GlobalLuaLoad struct {
+	File string `json:"file"`
+}
+
+// GlobalLuaOptions holds the lua-load directives (v3).
+type GlobalLuaOptions struct {
+	Loads []GlobalLuaLoad `json:"loads,omitempty"`
+}
+
+// LogTargetPayload is the payload for a log target of the global, defaults,
+// frontend, backend or log_forward sections.
+type LogTargetPayload struct {
+	Index    int64  `json:"index"`
+	Address  string `json:"address"`
+	Facility string `json:"facility,omitempty"`
+	Level    string `json:"level,omitempty"`
+	Minlevel string `json:"minlevel,omitempty"`
+	Format   string `json:"format,omitempty"`
+	Length   int64  `json:"length,omitempty"`
+}
+
+// DefaultsPayload is the payload for the defaults resource.
+type DefaultsPayload struct {
+	Name                 string             `json:"name"`
+	Mode                 string             `json:"mode,omitempty"`
+	Maxconn              int64              `json:"maxconn,omitempty"`
+	Retries              int64              `json:"retries,omitempty"`
+	HttpConnectionMode   string             `json:"http_connection_mode,omitempty"`
+	ClientTimeout        int64              `json:"client_timeout,omitempty"`
+	ServerTimeout        int64              `json:"server_timeout,omitempty"`
+	ConnectTimeout       int64              `json:"connect_timeout,omitempty"`
+	QueueTimeout         int64              `json:"queue_timeout,omitempty"`
+	TunnelTimeout        int64              `json:"tunnel_timeout,omitempty"`
+	CheckTimeout         int64              `json:"check_timeout,omitempty"`
+	HttpRequestTimeout   int64              `json:"http_request_timeout,omitempty"`
+	HttpKeepAliveTimeout int64              `json:"http_keep_alive_timeout,omitempty"`
+	Httplog              bool               `json:"httplog,omitempty"`
+	Tcplog               bool               `json:"tcplog,omitempty"`
+	Dontlognull          string             `json:"dontlognull,omitempty"`
+	LogSeparateErrors    string             `json:"log_separate_errors,omitempty"`
+	LogFormat            string             `json:"log_format,omitempty"`
+	Forwardfor           *ForwardFor        `json:"forwardfor,omitempty"`
+	ErrorFiles           []ErrorFilePayload `json:"error_files,omitempty"`
+}
+
+// ErrorFilePayload represents an errorfile directive.
+type ErrorFilePayload struct {
+	Code int64  `json:"code"`
+	File string `json:"file"`
 }
 
 // BackendPayload is the payload for the backend resource.
@@ -127,6 +231,7 @@ type BackendPayload struct {
 	TarpitTimeout      int64          `json:"tarpit_timeout,omitempty"`
 	CheckCache         string         `json:"checkcache,omitempty"`
 	Retries            int64          `json:"retries,omitempty"`
+	From               string         `json:"from,omitempty"`
 	Balance            *Balance       `json:"balance,omitempty"`
 	HttpchkParams      *HttpchkParams `json:"httpchk_params,omitempty"`
 	Forwardfor         *ForwardFor    `json:"forwardfor,omitempty"`
@@ -134,6 +239,41 @@ type BackendPayload struct {
 	// Default Server Configuration (for SSL/TLS settings)
 	DefaultServer *DefaultServerPayload `json:"default_server,omitempty"`
 	StatsOptions  *StatsOptionsPayload  `json:"stats_options,omitempty"`
+	StickTable    *BackendStickTable    `json:"stick_table,omitempty"`
+	EmailAlert    *EmailAlertPayload    `json:"email_alert,omitempty"`
+	Compression   *CompressionPayload   `json:"compression,omitempty"`
+
+	ErrorFiles               []ErrorFilePayload  `json:"error_files,omitempty"`
+	ErrorFilesFromHttpErrors []ErrorFilesPayload `json:"errorfiles_from_http_errors,omitempty"`
+}
+
+// CompressionPayload represents the compression algo, compression type and compression
+// offload settings of a frontend or backend.
+type CompressionPayload struct {
+	Algorithms []string `json:"algorithms,omitempty"`
+	Types      []string `json:"types,omitempty"`
+	Offload    bool     `json:"offload,omitempty"`
+}
+
+// EmailAlertPayload represents the email-alert settings of a backend; Mailers names a
+// mailers section.
+type EmailAlertPayload struct {
+	From       string `json:"from"`
+	To         string `json:"to"`
+	Level      string `json:"level,omitempty"`
+	Mailers    string `json:"mailers"`
+	Myhostname string `json:"myhostname,omitempty"`
+}
+
+// BackendStickTable represents the stick-table declared inside a backend
+type BackendStickTable struct {
+	Type    string `json:"type,omitempty"`
+	Size    int64  `json:"size,omitempty"`
+	Expire  int64  `json:"expire,omitempty"`
+	Nopurge bool   `json:"nopurge,omitempty"`
+	Peers   string `json:"peers,omitempty"`
+	Store   string `json:"store,omitempty"`
+	Keylen  int64  `json:"keylen,omitempty"`
 }
 
 type Balance struct {
@@ -697,14 +837,220 @@ type StickRulePayload struct {
 	Table    string `json:"table,omitempty"`
 }
 
-// StickTablePayload is the payload for the stick_table resource.
+// BackendSwitchingRulePayload is the payload of a use_backend rule of a frontend.
+type BackendSwitchingRulePayload struct {
+	Index    int64  `json:"index"`
+	Name     string `json:"name"`
+	Cond     string `json:"cond,omitempty"`
+	CondTest string `json:"cond_test,omitempty"`
+}
+
+// ServerSwitchingRulePayload is the payload of a use-server rule of a backend.
+type ServerSwitchingRulePayload struct {
+	Index        int64  `json:"index"`
+	TargetServer string `json:"target_server"`
+	Cond         string `json:"cond,omitempty"`
+	CondTest     string `json:"cond_test,omitempty"`
+}
+
+// HttpAfterResponseRulePayload is the payload of an http-after-response rule of a frontend or backend.
+type HttpAfterResponseRulePayload struct {
+	Index        int64  `json:"index"`
+	Type         string `json:"type"`
+	Cond         string `json:"cond,omitempty"`
+	CondTest     string `json:"cond_test,omitempty"`
+	HdrName      string `json:"hdr_name,omitempty"`
+	HdrFormat    string `json:"hdr_format,omitempty"`
+	HdrMatch     string `json:"hdr_match,omitempty"`
+	Status       int64  `json:"status,omitempty"`
+	StatusReason string `json:"status_reason,omitempty"`
+	VarName      string `json:"var_name,omitempty"`
+	VarScope     string `json:"var_scope,omitempty"`
+	VarExpr      string `json:"var_expr,omitempty"`
+	MapFile      string `json:"map_file,omitempty"`
+	MapKeyfmt    string `json:"map_keyfmt,omitempty"`
+	MapValuefmt  string `json:"map_valuefmt,omitempty"`
+	LogLevel     string `json:"log_level,omitempty"`
+	StrictMode   string `json:"strict_mode,omitempty"`
+}
+
+// FilterPayload is the payload of a filter line of a frontend or backend.
+type FilterPayload struct {
+	Index              int64  `json:"index"`
+	Type               string `json:"type"`
+	AppName            string `json:"app_name,omitempty"`
+	BandwidthLimitName string `json:"bandwidth_limit_name,omitempty"`
+	CacheName          string `json:"cache_name,omitempty"`
+	DefaultLimit       int64  `json:"default_limit,omitempty"`
+	DefaultPeriod      int64  `json:"default_period,omitempty"`
+	Key                string `json:"key,omitempty"`
+	Limit              int64  `json:"limit,omitempty"`
+	MinSize            int64  `json:"min_size,omitempty"`
+	SpoeConfig         string `json:"spoe_config,omitempty"`
+	SpoeEngine         string `json:"spoe_engine,omitempty"`
+	Table              string `json:"table,omitempty"`
+	TraceName          string `json:"trace_name,omitempty"`
+	TraceHexdump       bool   `json:"trace_hexdump,omitempty"`
+	TraceRndForwarding bool   `json:"trace_rnd_forwarding,omitempty"`
+	TraceRndParsing    bool   `json:"trace_rnd_parsing,omitempty"`
+}
+
+// StickTablePayload is the payload for the stick_table resource. HAProxy has no dedicated
+// table section, so the table is stored as a backend that only declares a stick-table.
 type StickTablePayload struct {
+	Name       string             `json:"name"`
+	StickTable *BackendStickTable `json:"stick_table,omitempty"`
+}
+
+// CachePayload is the payload for the cache resource.
+type CachePayload struct {
+	Name          string `json:"name"`
+	TotalMaxSize  int64  `json:"total_max_size,omitempty"`
+	MaxAge        int64  `json:"max_age,omitempty"`
+	MaxObjectSize int64  `json:"max_object_size,omitempty"`
+	ProcessVary   *bool  `json:"process_vary,omitempty"`
+}
+
+// ErrorFilesPayload takes the error pages of Codes from an http-errors section (errorfiles).
+type ErrorFilesPayload struct {
+	Name  string  `json:"name"`
+	Codes []int64 `json:"codes,omitempty"`
+}
+
+// HttpErrorsPayload is the payload for the http_errors resource.
+type HttpErrorsPayload struct {
+	Name       string             `json:"name"`
+	ErrorFiles []ErrorFilePayload `json:"error_files"`
+}
+
+// GeneralFilePayload describes a file of the Data Plane API general storage.
+type GeneralFilePayload struct {
+	StorageName string `json:"storage_name"`
+	File        string `json:"file"`
+}
+
+// SslCertificatePayload describes a PEM file of the Data Plane API certificate storage.
+type SslCertificatePayload struct {
+	StorageName string `json:"storage_name"`
+	File        string `json:"file"`
+	Description string `json:"description,omitempty"`
+}
+
+// MapFilePayload describes a file of the Data Plane API map storage.
+type MapFilePayload struct {
+	StorageName string `json:"storage_name"`
+	File        string `json:"file"`
+	Description string `json:"description,omitempty"`
+}
+
+// MapEntryPayload is a key of a map loaded in the running HAProxy process.
+type MapEntryPayload struct {
+	ID    string `json:"id,omitempty"`
+	Key   string `json:"key,omitempty"`
+	Value string `json:"value"`
+}
+
+// RuntimeServerPayload is the state of a server in the running HAProxy process.
+type RuntimeServerPayload struct {
+	Name             string `json:"name,omitempty"`
+	Address          string `json:"address,omitempty"`
+	Port             *int64 `json:"port,omitempty"`
+	AdminState       string `json:"admin_state,omitempty"`
+	OperationalState string `json:"operational_state,omitempty"`
+	Weight           *int64 `json:"weight,omitempty"`
+}
+
+// InfoPayload describes the Data Plane API and its host, as returned by /info.
+type InfoPayload struct {
+	API struct {
+		Version   string `json:"version,omitempty"`
+		BuildDate string `json:"build_date,omitempty"`
+	} `json:"api"`
+	System struct {
+		Hostname string `json:"hostname,omitempty"`
+		OsString string `json:"os_string,omitempty"`
+		Uptime   *int64 `json:"uptime,omitempty"`
+	} `json:"system"`
+}
+
+// ProcessInfoPayload describes the running HAProxy process, as returned by one runtime API socket.
+type ProcessInfoPayload struct {
+	RuntimeAPI string `json:"runtimeAPI,omitempty"`
+	Error      string `json:"error,omitempty"`
+	Info       struct {
+		Version     string `json:"version,omitempty"`
+		ReleaseDate string `json:"release_date,omitempty"`
+		Node        string `json:"node,omitempty"`
+		Pid         *int64 `json:"pid,omitempty"`
+		Uptime      *int64 `json:"uptime,omitempty"`
+	} `json:"info"`
+}
+
+// NativeStatsCollectionPayload holds the statistics returned by one runtime API socket.
+type NativeStatsCollectionPayload struct {
+	RuntimeAPI string              `json:"runtimeAPI,omitempty"`
+	Stats      []NativeStatPayload `json:"stats,omitempty"`
+	Error      string              `json:"error,omitempty"`
+}
+
+// NativeStatPayload is the statistics line of a frontend, backend or server.
+type NativeStatPayload struct {
+	Type        string                 `json:"type"`
+	Name        string                 `json:"name,omitempty"`
+	BackendName string                 `json:"backend_name,omitempty"`
+	Stats       NativeStatStatsPayload `json:"stats"`
+}
+
+// NativeStatStatsPayload holds the counters of a statistics line. Counters that do not apply
+// to the type of the line are omitted by HAProxy.
+type NativeStatStatsPayload struct {
+	Status      string `json:"status,omitempty"`
+	CheckStatus string `json:"check_status,omitempty"`
+	Scur        *int64 `json:"scur,omitempty"`
+	Smax        *int64 `json:"smax,omitempty"`
+	Slim        *int64 `json:"slim,omitempty"`
+	Stot        *int64 `json:"stot,omitempty"`
+	Rate        *int64 `json:"rate,omitempty"`
+	RateMax     *int64 `json:"rate_max,omitempty"`
+	Bin         *int64 `json:"bin,omitempty"`
+	Bout        *int64 `json:"bout,omitempty"`
+	Ereq        *int64 `json:"ereq,omitempty"`
+	Econ        *int64 `json:"econ,omitempty"`
+	Eresp       *int64 `json:"eresp,omitempty"`
+	Weight      *int64 `json:"weight,omitempty"`
+}
+
+// MailersPayload is the payload for the mailers resource.
+type MailersPayload struct {
 	Name    string `json:"name"`
-	Type    string `json:"type,omitempty"`
-	Size    string `json:"size,omitempty"`
-	Store   string `json:"store,omitempty"`
-	Peers   string `json:"peers,omitempty"`
-	NoPurge bool   `json:"no_purge,omitempty"`
+	Timeout int64  `json:"timeout,omitempty"`
+}
+
+// MailerEntryPayload is the payload for a mailer of a mailers section.
+type MailerEntryPayload struct {
+	Name    string `json:"name"`
+	Address string `json:"address"`
+	Port    int64  `json:"port"`
+}
+
+// UserlistPayload is the payload for the userlist resource.
+type UserlistPayload struct {
+	Name string `json:"name"`
+}
+
+// UserPayload is the payload for a user of a userlist. SecurePassword marks Password as a
+// crypt(3) hash rather than a plain-text insecure-password.
+type UserPayload struct {
+	Username       string `json:"username"`
+	Password       string `json:"password"`
+	SecurePassword bool   `json:"secure_password"`
+	Groups         string `json:"groups,omitempty"`
+}
+
+// GroupPayload is the payload for a group of a userlist; Users is a comma-separated list.
+type GroupPayload struct {
+	Name  string `json:"name"`
+	Users string `json:"users,omitempty"`
 }
 
 // HttpcheckPayload is the payload for the httpcheck resource.
@@ -863,9 +1209,15 @@ type TcpResponseRulePayload struct {
 
 // LogForwardPayload is the payload for the logforward resource.
 type LogForwardPayload struct {
-	Name     string `json:"name"`
-	Backlog  int64  `json:"backlog,omitempty"`
-	Maxconn  int64  `json:"maxconn,omitempty"`
-	Timeout  int64  `json:"timeout,omitempty"`
-	Loglevel string `json:"loglevel,omitempty"`
+	Name          string `json:"name"`
+	Backlog       int64  `json:"backlog,omitempty"`
+	Maxconn       int64  `json:"maxconn,omitempty"`
+	TimeoutClient int64  `json:"timeout_client,omitempty"`
+}
+
+// DgramBindPayload is the payload for a dgram-bind of a log_forward section.
+type DgramBindPayload struct {
+	Name    string `json:"name"`
+	Address string `json:"address"`
+	Port    *int64 `json:"port,omitempty"`
 }
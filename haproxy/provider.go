@@ -3,12 +3,15 @@ package haproxy
 import (
 	"context"
 	"crypto/tls"
+	"fmt"
 	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -67,8 +70,11 @@ func (p *haproxyProvider) Schema(_ context.Context, _ provider.SchemaRequest, re
 				Optional:    true,
 			},
 			"api_version": schema.StringAttribute{
-				Description: "The version of the HAProxy Data Plane API to use.",
+				Description: "The version of the HAProxy Data Plane API to use (v2 or v3). Detected from the /info endpoint when unset.",
 				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("v2", "v3"),
+				},
 			},
 		},
 	}
@@ -93,9 +99,20 @@ func (p *haproxyProvider) Configure(ctx context.Context, req provider.ConfigureR
 		}
 	}
 
+	// Check the connection up front so that an unreachable API, wrong credentials or a
+	// wrong api_version fail here rather than as 404s in the first resource
 	apiVersion := config.APIVersion.ValueString()
-	if apiVersion == "" {
-		apiVersion = "v3"
+	if config.URL.IsUnknown() || config.Username.IsUnknown() || config.Password.IsUnknown() || config.APIVersion.IsUnknown() {
+		if apiVersion == "" {
+			apiVersion = "v3"
+		}
+	} else {
+		detected, err := detectAPIVersion(ctx, httpClient, config.URL.ValueString(), config.Username.ValueString(), config.Password.ValueString(), apiVersion)
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to connect to the HAProxy Data Plane API", err.Error())
+			return
+		}
+		apiVersion = detected
 	}
 
 	client := NewHAProxyClient(httpClient, config.URL.ValueString(), config.Username.ValueString(), config.Password.ValueString(), apiVersion)
@@ -120,6 +137,7 @@ func (p *haproxyProvider) DataSources(_ context.Context) []func() datasource.Dat
 		NewHttpcheckDataSource,
 		NewHttpRequestRuleDataSource,
 		NewHttpResponseRuleDataSource,
+		NewHttpAfterResponseRuleDataSource,
 		NewServerDataSource,
 		NewTcpCheckDataSource,
 		NewTcpCheckSingleDataSource,
@@ -134,8 +152,12 @@ func (p *haproxyProvider) DataSources(_ context.Context) []func() datasource.Dat
 		NewHttpcheckSingleDataSource,
 		NewHttpRequestRuleSingleDataSource,
 		NewHttpResponseRuleSingleDataSource,
+		NewHttpAfterResponseRuleSingleDataSource,
 		NewBindDataSource,
 		NewBindSingleDataSource,
+		NewResolversDataSource,
+		NewStatsDataSource,
+		NewInfoDataSource,
 	}
 }
 
@@ -143,5 +165,49 @@ func (p *haproxyProvider) DataSources(_ context.Context) []func() datasource.Dat
 func (p *haproxyProvider) Resources(_ context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewHaproxyStackResource,
+		NewServerResource,
+		NewAclResource,
+		NewBindResource,
+		NewHttpRequestRuleResource,
+		NewHttpResponseRuleResource,
+		NewResolversResource,
+		NewPeersResource,
+		NewGlobalResource,
+		NewDefaultsResource,
+		NewLogForwardResource,
+		NewStickTableResource,
+		NewUserlistResource,
+		NewCacheResource,
+		NewMailersResource,
+		NewHttpErrorsResource,
+		NewSslCertificateResource,
+		NewMapFileResource,
+		NewMapEntryResource,
+		NewServerStateResource,
+	}
+}
+
+// detectAPIVersion calls /info to check that the Data Plane API is reachable with the given
+// credentials. When requested is empty, v3 is tried before v2 and the first version served
+// is returned.
+func detectAPIVersion(ctx context.Context, httpClient *http.Client, url, username, password, requested string) (string, error) {
+	candidates := []string{"v3", "v2"}
+	if requested != "" {
+		candidates = []string{requested}
+	}
+
+	for _, candidate := range candidates {
+		info, err := NewHAProxyClient(httpClient, url, username, password, candidate).ReadInfo(ctx)
+		if err != nil {
+			return "", err
+		}
+		if info != nil {
+			return candidate, nil
+		}
+	}
+
+	if requested != "" {
+		return "", fmt.Errorf("the Data Plane API at %s does not serve api_version %s; check api_version or leave it unset to detect it", url, requested)
 	}
+	return "", fmt.Errorf("the Data Plane API at %s serves neither v3 nor v2; check that url points to the Data Plane API and not to HAProxy itself", url)
 }
@@ -0,0 +1,223 @@
+package haproxy
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &aclResource{}
+	_ resource.ResourceWithConfigure   = &aclResource{}
+	_ resource.ResourceWithImportState = &aclResource{}
+)
+
+// NewAclResource is a helper function to simplify the provider implementation.
+func NewAclResource() resource.Resource {
+	return &aclResource{}
+}
+
+// aclResource manages a single ACL on a frontend or backend.
+type aclResource struct {
+	client *HAProxyClient
+}
+
+// aclResourceModel maps the resource schema data.
+// The ACL fields are shared with the acls block of haproxy_stack.
+type aclResourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	ParentType types.String `tfsdk:"parent_type"`
+	ParentName types.String `tfsdk:"parent_name"`
+	haproxyAclModel
+}
+
+// Metadata returns the resource type name.
+func (r *aclResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_acl"
+}
+
+// Schema defines the schema for the resource.
+func (r *aclResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := GetACLSchema().NestedObject.Attributes
+	for name, attribute := range GetParentAttributes("frontend", "backend") {
+		attributes[name] = attribute
+	}
+	attributes["index"] = schema.Int64Attribute{
+		Required:    true,
+		Description: "The position of the ACL in the parent's ACL list.",
+		PlanModifiers: []planmodifier.Int64{
+			int64planmodifier.RequiresReplace(),
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Manages a single ACL on a frontend or backend. It can be combined with acls on an haproxy_stack that owns the same parent, since the stack only rewrites its own ACLs. ACLs are positional, so adding or removing stack ACLs placed before this one shifts its index.",
+		Attributes:  attributes,
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *aclResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *aclResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan aclResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	parentType := plan.ParentType.ValueString()
+	parentName := plan.ParentName.ValueString()
+	payload := r.toPayload(&plan)
+
+	err := r.client.InTransaction(func(transactionID string) error {
+		return r.client.CreateACLInTransaction(ctx, transactionID, parentType, parentName, payload)
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating ACL",
+			fmt.Sprintf("Could not create ACL %s on %s %s: %s", payload.AclName, parentType, parentName, err),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(fmt.Sprintf("%s/%s/%d", parentType, parentName, payload.Index))
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *aclResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state aclResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	acls, err := r.client.ReadACLs(ctx, state.ParentType.ValueString(), state.ParentName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading ACLs",
+			fmt.Sprintf("Could not read ACLs: %s", err),
+		)
+		return
+	}
+
+	// ACLs are addressed by position; if the list has shrunk the ACL is gone
+	index := state.Index.ValueInt64()
+	if index < 0 || index >= int64(len(acls)) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	acl := acls[index]
+	state.AclName = types.StringValue(acl.AclName)
+	state.Criterion = types.StringValue(acl.Criterion)
+	state.Value = types.StringValue(acl.Value)
+	state.ID = types.StringValue(fmt.Sprintf("%s/%s/%d", state.ParentType.ValueString(), state.ParentName.ValueString(), index))
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *aclResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan aclResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	parentType := plan.ParentType.ValueString()
+	parentName := plan.ParentName.ValueString()
+	payload := r.toPayload(&plan)
+
+	err := r.client.InTransaction(func(transactionID string) error {
+		return r.client.UpdateACLInTransaction(ctx, transactionID, parentType, parentName, payload.Index, payload)
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating ACL",
+			fmt.Sprintf("Could not update ACL %s on %s %s: %s", payload.AclName, parentType, parentName, err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *aclResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state aclResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	parentType := state.ParentType.ValueString()
+	parentName := state.ParentName.ValueString()
+	index := state.Index.ValueInt64()
+
+	err := r.client.InTransaction(func(transactionID string) error {
+		return r.client.DeleteACLInTransaction(ctx, transactionID, parentType, parentName, index)
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting ACL",
+			fmt.Sprintf("Could not delete ACL %d on %s %s: %s", index, parentType, parentName, err),
+		)
+	}
+}
+
+// ImportState imports an ACL using the ID format parent_type/parent_name/index.
+func (r *aclResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parentType, parentName, indexStr, err := parseChildImportID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", err.Error())
+		return
+	}
+
+	index, err := strconv.ParseInt(indexStr, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid index in import ID",
+			fmt.Sprintf("Index must be a number: %s", err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("parent_type"), parentType)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("parent_name"), parentName)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("index"), index)...)
+}
+
+// toPayload converts the resource model to an ACLPayload.
+func (r *aclResource) toPayload(model *aclResourceModel) *ACLPayload {
+	return &ACLPayload{
+		AclName:   model.AclName.ValueString(),
+		Criterion: model.Criterion.ValueString(),
+		Value:     model.Value.ValueString(),
+		Index:     model.Index.ValueInt64(),
+	}
+}
@@ -37,6 +37,7 @@ func (r *BackendManager) CreateBackend(ctx context.Context, plan *haproxyBackend
 		TarpitTimeout:      plan.TarpitTimeout.ValueInt64(),
 		CheckCache:         plan.Checkcache.ValueString(),
 		Retries:            plan.Retries.ValueInt64(),
+		From:               plan.Defaults.ValueString(),
 
 		// Process nested blocks (only those supported by BackendPayload)
 		Balance:       r.processBalanceBlock(plan.Balance),
@@ -44,7 +45,11 @@ func (r *BackendManager) CreateBackend(ctx context.Context, plan *haproxyBackend
 		Forwardfor:    r.processForwardforBlock(plan.Forwardfor),
 		DefaultServer: r.processDefaultServerBlock(plan.DefaultServer),
 		StatsOptions:  r.processStatsOptionsBlock(plan.StatsOptions),
+		StickTable:    r.processStickTableBlock(plan.StickTable),
+		EmailAlert:    r.processEmailAlertBlock(plan.EmailAlert),
+		Compression:   processCompressionBlock(plan.Compression),
 	}
+	backendPayload.ErrorFiles, backendPayload.ErrorFilesFromHttpErrors = CreateErrorFilesManager(r.client).processErrorFilesBlock(plan.ErrorFiles)
 
 	// Create backend in HAProxy
 	err := r.client.CreateBackend(ctx, backendPayload)
@@ -72,6 +77,7 @@ func (r *BackendManager) CreateBackendInTransaction(ctx context.Context, transac
 		TarpitTimeout:      plan.TarpitTimeout.ValueInt64(),
 		CheckCache:         plan.Checkcache.ValueString(),
 		Retries:            plan.Retries.ValueInt64(),
+		From:               plan.Defaults.ValueString(),
 
 		// Process nested blocks (only those supported by BackendPayload)
 		Balance:       r.processBalanceBlock(plan.Balance),
@@ -79,7 +85,11 @@ func (r *BackendManager) CreateBackendInTransaction(ctx context.Context, transac
 		Forwardfor:    r.processForwardforBlock(plan.Forwardfor),
 		DefaultServer: r.processDefaultServerBlock(plan.DefaultServer),
 		StatsOptions:  r.processStatsOptionsBlock(plan.StatsOptions),
+		StickTable:    r.processStickTableBlock(plan.StickTable),
+		EmailAlert:    r.processEmailAlertBlock(plan.EmailAlert),
+		Compression:   processCompressionBlock(plan.Compression),
 	}
+	backendPayload.ErrorFiles, backendPayload.ErrorFilesFromHttpErrors = CreateErrorFilesManager(r.client).processErrorFilesBlock(plan.ErrorFiles)
 
 	// Create backend in HAProxy using the existing transaction
 	if err := r.client.CreateBackendInTransaction(ctx, transactionID, backendPayload); err != nil {
@@ -106,6 +116,7 @@ func (r *BackendManager) UpdateBackendInTransaction(ctx context.Context, transac
 		TarpitTimeout:      plan.TarpitTimeout.ValueInt64(),
 		CheckCache:         plan.Checkcache.ValueString(),
 		Retries:            plan.Retries.ValueInt64(),
+		From:               plan.Defaults.ValueString(),
 
 		// Process nested blocks (only those supported by BackendPayload)
 		Balance:       r.processBalanceBlock(plan.Balance),
@@ -113,7 +124,11 @@ func (r *BackendManager) UpdateBackendInTransaction(ctx context.Context, transac
 		Forwardfor:    r.processForwardforBlock(plan.Forwardfor),
 		DefaultServer: r.processDefaultServerBlock(plan.DefaultServer),
 		StatsOptions:  r.processStatsOptionsBlock(plan.StatsOptions),
+		StickTable:    r.processStickTableBlock(plan.StickTable),
+		EmailAlert:    r.processEmailAlertBlock(plan.EmailAlert),
+		Compression:   processCompressionBlock(plan.Compression),
 	}
+	backendPayload.ErrorFiles, backendPayload.ErrorFilesFromHttpErrors = CreateErrorFilesManager(r.client).processErrorFilesBlock(plan.ErrorFiles)
 
 	// Update backend in HAProxy using the existing transaction
 	err := r.client.UpdateBackendInTransaction(ctx, transactionID, backendPayload)
@@ -184,6 +199,70 @@ func (r *BackendManager) ReadBackend(ctx context.Context, backendName string, ex
 	if backend.Retries != 0 {
 		backendModel.Retries = types.Int64Value(backend.Retries)
 	}
+	if backend.From != "" {
+		backendModel.Defaults = types.StringValue(backend.From)
+	}
+
+	// Map nested blocks HAProxy returned
+	if backend.Balance != nil && backend.Balance.Algorithm != "" {
+		balanceModel := haproxyBalanceModel{
+			Algorithm: types.StringValue(backend.Balance.Algorithm),
+		}
+		if backend.Balance.UrlParam != "" {
+			balanceModel.UrlParam = types.StringValue(backend.Balance.UrlParam)
+		}
+		backendModel.Balance = []haproxyBalanceModel{balanceModel}
+	}
+	if backend.HttpchkParams != nil && (backend.HttpchkParams.Method != "" || backend.HttpchkParams.Uri != "") {
+		httpchkModel := haproxyHttpchkParamsModel{
+			Method: types.StringValue(backend.HttpchkParams.Method),
+			Uri:    types.StringValue(backend.HttpchkParams.Uri),
+		}
+		if backend.HttpchkParams.Version != "" {
+			httpchkModel.Version = types.StringValue(backend.HttpchkParams.Version)
+		}
+		backendModel.HttpchkParams = []haproxyHttpchkParamsModel{httpchkModel}
+	}
+	if backend.Forwardfor != nil && backend.Forwardfor.Enabled != "" {
+		backendModel.Forwardfor = []haproxyForwardforModel{
+			{Enabled: types.StringValue(backend.Forwardfor.Enabled)},
+		}
+	}
+	backendModel.StatsOptions = convertStatsOptionsFromPayload(backend.StatsOptions)
+	if backend.StickTable != nil && backend.StickTable.Type != "" {
+		backendModel.StickTable = &haproxyStickTableModel{
+			Type: types.StringValue(backend.StickTable.Type),
+		}
+		if backend.StickTable.Size != 0 {
+			backendModel.StickTable.Size = types.Int64Value(backend.StickTable.Size)
+		}
+		if backend.StickTable.Expire != 0 {
+			backendModel.StickTable.Expire = types.Int64Value(backend.StickTable.Expire)
+		}
+		if backend.StickTable.Nopurge {
+			backendModel.StickTable.Nopurge = types.BoolValue(true)
+		}
+		if backend.StickTable.Peers != "" {
+			backendModel.StickTable.Peers = types.StringValue(backend.StickTable.Peers)
+		}
+	}
+	if backend.EmailAlert != nil && backend.EmailAlert.Mailers != "" {
+		backendModel.EmailAlert = &haproxyEmailAlertModel{
+			From:       types.StringValue(backend.EmailAlert.From),
+			To:         types.StringValue(backend.EmailAlert.To),
+			Level:      stringOrNull(backend.EmailAlert.Level),
+			Mailers:    types.StringValue(backend.EmailAlert.Mailers),
+			Myhostname: stringOrNull(backend.EmailAlert.Myhostname),
+		}
+	}
+
+	backendModel.Compression = convertCompressionFromPayload(backend.Compression)
+
+	var existingErrorFiles []haproxyErrorFilesModel
+	if existingBackend != nil {
+		existingErrorFiles = existingBackend.ErrorFiles
+	}
+	backendModel.ErrorFiles = CreateErrorFilesManager(r.client).Read(ctx, backend.ErrorFiles, backend.ErrorFilesFromHttpErrors, existingErrorFiles)
 
 	// Handle adv_check based on whether httpchk_params is present
 	if existingBackend != nil && len(existingBackend.HttpchkParams) > 0 && existingBackend.AdvCheck.IsNull() {
@@ -301,7 +380,7 @@ func (r *BackendManager) ReadBackend(ctx context.Context, backendName string, ex
 			aclModels = append(aclModels, haproxyAclModel{
 				AclName:   types.StringValue(acl.AclName),
 				Criterion: types.StringValue(acl.Criterion),
-				Value:     types.StringValue(acl.Value),
+				Value:     stringOrNull(acl.Value),
 			})
 		}
 		backendModel.Acls = aclModels
@@ -332,6 +411,7 @@ func (r *BackendManager) UpdateBackend(ctx context.Context, plan *haproxyBackend
 		TarpitTimeout:      plan.TarpitTimeout.ValueInt64(),
 		CheckCache:         plan.Checkcache.ValueString(),
 		Retries:            plan.Retries.ValueInt64(),
+		From:               plan.Defaults.ValueString(),
 
 		// Process nested blocks (only those supported by BackendPayload)
 		Balance:       r.processBalanceBlock(plan.Balance),
@@ -339,7 +419,11 @@ func (r *BackendManager) UpdateBackend(ctx context.Context, plan *haproxyBackend
 		Forwardfor:    r.processForwardforBlock(plan.Forwardfor),
 		DefaultServer: r.processDefaultServerBlock(plan.DefaultServer),
 		StatsOptions:  r.processStatsOptionsBlock(plan.StatsOptions),
+		StickTable:    r.processStickTableBlock(plan.StickTable),
+		EmailAlert:    r.processEmailAlertBlock(plan.EmailAlert),
+		Compression:   processCompressionBlock(plan.Compression),
 	}
+	backendPayload.ErrorFiles, backendPayload.ErrorFilesFromHttpErrors = CreateErrorFilesManager(r.client).processErrorFilesBlock(plan.ErrorFiles)
 
 	// Update backend in HAProxy
 	err := r.client.UpdateBackend(ctx, plan.Name.ValueString(), backendPayload)
@@ -426,6 +510,7 @@ func (r *BackendManager) processBalanceBlock(balance []haproxyBalanceModel) *Bal
 	b := balance[0]
 	return &Balance{
 		Algorithm: b.Algorithm.ValueString(),
+		UrlParam:  b.UrlParam.ValueString(),
 	}
 }
 
@@ -591,7 +676,35 @@ func (r *BackendManager) processDefaultServerBlock(defaultServer *haproxyDefault
 	return payload
 }
 
-// Helper functions for stick table and stick rule are not needed for BackendPayload
+// processStickTableBlock converts the stick_table block; peers names a peers section
+// (for example one managed by haproxy_peers) that the table is replicated to.
+func (r *BackendManager) processStickTableBlock(stickTable *haproxyStickTableModel) *BackendStickTable {
+	if stickTable == nil || stickTable.Type.ValueString() == "" {
+		return nil
+	}
+	return &BackendStickTable{
+		Type:    stickTable.Type.ValueString(),
+		Size:    stickTable.Size.ValueInt64(),
+		Expire:  stickTable.Expire.ValueInt64(),
+		Nopurge: stickTable.Nopurge.ValueBool(),
+		Peers:   stickTable.Peers.ValueString(),
+	}
+}
+
+// processEmailAlertBlock converts the email_alert block; mailers names a mailers section
+// (for example one managed by haproxy_mailers).
+func (r *BackendManager) processEmailAlertBlock(emailAlert *haproxyEmailAlertModel) *EmailAlertPayload {
+	if emailAlert == nil {
+		return nil
+	}
+	return &EmailAlertPayload{
+		From:       emailAlert.From.ValueString(),
+		To:         emailAlert.To.ValueString(),
+		Level:      emailAlert.Level.ValueString(),
+		Mailers:    emailAlert.Mailers.ValueString(),
+		Myhostname: emailAlert.Myhostname.ValueString(),
+	}
+}
 
 func (r *BackendManager) processStatsOptionsBlock(statsOptions []haproxyStatsOptionsModel) *StatsOptionsPayload {
 	if len(statsOptions) == 0 {
@@ -611,6 +724,43 @@ func (r *BackendManager) processStatsOptionsBlock(statsOptions []haproxyStatsOpt
 	}
 }
 
+// convertStatsOptionsFromPayload converts StatsOptionsPayload to haproxyStatsOptionsModel
+func convertStatsOptionsFromPayload(statsOptions *StatsOptionsPayload) []haproxyStatsOptionsModel {
+	if statsOptions == nil {
+		return nil
+	}
+	model := haproxyStatsOptionsModel{
+		StatsEnable: types.BoolValue(statsOptions.StatsEnable),
+	}
+	if statsOptions.StatsUri != "" {
+		model.StatsUri = types.StringValue(statsOptions.StatsUri)
+	}
+	if statsOptions.StatsRealm != "" {
+		model.StatsRealm = types.StringValue(statsOptions.StatsRealm)
+	}
+	if statsOptions.StatsAuth != "" {
+		model.StatsAuth = types.StringValue(statsOptions.StatsAuth)
+	}
+	return []haproxyStatsOptionsModel{model}
+}
+
+// refreshBackendBlocks copies the blocks read from HAProxy into the stack state so that changes
+// made outside of Terraform show up as drift. Booleans HAProxy reports as false keep a configured false.
+func refreshBackendBlocks(state, live *haproxyBackendModel) {
+	if len(state.StatsOptions) == 1 && len(live.StatsOptions) == 1 {
+		live.StatsOptions[0].StatsEnable = boolOrPrior(live.StatsOptions[0].StatsEnable.ValueBool(), state.StatsOptions[0].StatsEnable)
+	}
+	if state.StickTable != nil && live.StickTable != nil {
+		live.StickTable.Nopurge = boolOrPrior(live.StickTable.Nopurge.ValueBool(), state.StickTable.Nopurge)
+	}
+
+	state.Balance = live.Balance
+	state.HttpchkParams = live.HttpchkParams
+	state.Forwardfor = live.Forwardfor
+	state.StatsOptions = live.StatsOptions
+	state.StickTable = live.StickTable
+}
+
 const (
 	enabledValue  = "enabled"
 	disabledValue = "disabled"
@@ -0,0 +1,227 @@
+package haproxy
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &bindResource{}
+	_ resource.ResourceWithConfigure   = &bindResource{}
+	_ resource.ResourceWithImportState = &bindResource{}
+)
+
+// NewBindResource is a helper function to simplify the provider implementation.
+func NewBindResource() resource.Resource {
+	return &bindResource{}
+}
+
+// bindResource manages a single bind on a frontend.
+type bindResource struct {
+	client  *HAProxyClient
+	manager *BindManager
+}
+
+// bindResourceModel maps the resource schema data.
+// The bind fields are shared with the binds map of haproxy_stack; the bind's own
+// "id" attribute is the HAProxy bind id, so this resource has no separate id.
+type bindResourceModel struct {
+	ParentType types.String `tfsdk:"parent_type"`
+	ParentName types.String `tfsdk:"parent_name"`
+	Name       types.String `tfsdk:"name"`
+	haproxyBindModel
+}
+
+// Metadata returns the resource type name.
+func (r *bindResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_bind"
+}
+
+// Schema defines the schema for the resource.
+func (r *bindResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := GetBindSchema().NestedObject.Attributes
+	parentAttributes := GetParentAttributes("frontend")
+	attributes["parent_type"] = parentAttributes["parent_type"]
+	attributes["parent_name"] = parentAttributes["parent_name"]
+	attributes["name"] = schema.StringAttribute{
+		Required:    true,
+		Description: "The name of the bind.",
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Manages a single bind on a frontend. Binds created this way are ignored by an haproxy_stack that owns the same frontend.",
+		Attributes:  attributes,
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *bindResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+	r.manager = CreateBindManager(providerData.Client)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *bindResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan bindResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	parentType := plan.ParentType.ValueString()
+	parentName := plan.ParentName.ValueString()
+	payload := r.manager.convertToBindPayload(plan.Name.ValueString(), &plan.haproxyBindModel)
+
+	err := r.client.InTransaction(func(transactionID string) error {
+		return checkBindResponse(r.client.CreateBindInTransaction(ctx, transactionID, parentType, parentName, payload))
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating bind",
+			fmt.Sprintf("Could not create bind %s on %s %s: %s", payload.Name, parentType, parentName, err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *bindResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state bindResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	binds, err := r.client.ReadBinds(ctx, state.ParentType.ValueString(), state.ParentName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading binds",
+			fmt.Sprintf("Could not read binds: %s", err),
+		)
+		return
+	}
+
+	var found *BindPayload
+	for i := range binds {
+		if binds[i].Name == state.Name.ValueString() {
+			found = &binds[i]
+			break
+		}
+	}
+	if found == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	bind := r.manager.convertFromBindPayload(*found)
+	// TLS version toggles default to enabled in HAProxy; only keep the configured values
+	bind.Tlsv10 = state.Tlsv10
+	bind.Tlsv11 = state.Tlsv11
+	bind.Tlsv12 = state.Tlsv12
+	bind.Tlsv13 = state.Tlsv13
+	state.haproxyBindModel = bind
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *bindResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan bindResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	parentType := plan.ParentType.ValueString()
+	parentName := plan.ParentName.ValueString()
+	payload := r.manager.convertToBindPayload(plan.Name.ValueString(), &plan.haproxyBindModel)
+
+	err := r.client.InTransaction(func(transactionID string) error {
+		return checkBindResponse(r.client.UpdateBindInTransaction(ctx, transactionID, payload.Name, parentType, parentName, payload))
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating bind",
+			fmt.Sprintf("Could not update bind %s on %s %s: %s", payload.Name, parentType, parentName, err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *bindResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state bindResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	parentType := state.ParentType.ValueString()
+	parentName := state.ParentName.ValueString()
+	name := state.Name.ValueString()
+
+	err := r.client.InTransaction(func(transactionID string) error {
+		return checkBindResponse(r.client.DeleteBindInTransaction(ctx, transactionID, name, parentType, parentName))
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting bind",
+			fmt.Sprintf("Could not delete bind %s on %s %s: %s", name, parentType, parentName, err),
+		)
+	}
+}
+
+// ImportState imports a bind using the ID format parent_type/parent_name/name.
+func (r *bindResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parentType, parentName, name, err := parseChildImportID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("parent_type"), parentType)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("parent_name"), parentName)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
+}
+
+// checkBindResponse turns a non-success bind API response into an error.
+func checkBindResponse(resp *http.Response, err error) error {
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("bind request failed with status %d: %s", resp.StatusCode, string(body))
+	}
+	return nil
+}
@@ -0,0 +1,205 @@
+package haproxy
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &cacheResource{}
+	_ resource.ResourceWithConfigure   = &cacheResource{}
+	_ resource.ResourceWithImportState = &cacheResource{}
+)
+
+// NewCacheResource is a helper function to simplify the provider implementation.
+func NewCacheResource() resource.Resource {
+	return &cacheResource{}
+}
+
+// cacheResource manages a cache section.
+type cacheResource struct {
+	client *HAProxyClient
+}
+
+// cacheResourceModel maps the resource schema data.
+type cacheResourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	TotalMaxSize  types.Int64  `tfsdk:"total_max_size"`
+	MaxAge        types.Int64  `tfsdk:"max_age"`
+	MaxObjectSize types.Int64  `tfsdk:"max_object_size"`
+	ProcessVary   types.Bool   `tfsdk:"process_vary"`
+}
+
+// Metadata returns the resource type name.
+func (r *cacheResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cache"
+}
+
+// Schema defines the schema for the resource.
+func (r *cacheResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages an HAProxy cache section, used by the cache-use and cache-store actions of HTTP rules.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The name of the cache.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the cache, referenced by the cache_name attribute of cache-use and cache-store rules.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"total_max_size": schema.Int64Attribute{
+				Optional:    true,
+				Description: "The size of the cache in megabytes (total-max-size).",
+			},
+			"max_age": schema.Int64Attribute{
+				Optional:    true,
+				Description: "The maximum time an object is kept, in seconds (max-age).",
+			},
+			"max_object_size": schema.Int64Attribute{
+				Optional:    true,
+				Description: "The maximum size of a cached object, in bytes (max-object-size).",
+			},
+			"process_vary": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether to cache responses carrying a Vary header (process-vary).",
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *cacheResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+// Create creates the cache section.
+func (r *cacheResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan cacheResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := plan.Name.ValueString()
+	err := r.client.InTransaction(func(transactionID string) error {
+		return r.client.CreateCacheInTransaction(ctx, transactionID, r.toPayload(&plan))
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating cache", fmt.Sprintf("Could not create cache %s: %s", name, err))
+		return
+	}
+
+	plan.ID = types.StringValue(name)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *cacheResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state cacheResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := state.Name.ValueString()
+	cache, err := r.client.ReadCache(ctx, name)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading cache", fmt.Sprintf("Could not read cache %s: %s", name, err))
+		return
+	}
+	if cache == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.ID = types.StringValue(name)
+	state.TotalMaxSize = int64OrNull(cache.TotalMaxSize)
+	state.MaxAge = int64OrNull(cache.MaxAge)
+	state.MaxObjectSize = int64OrNull(cache.MaxObjectSize)
+	state.ProcessVary = types.BoolPointerValue(cache.ProcessVary)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Update updates the cache section in place.
+func (r *cacheResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan cacheResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := plan.Name.ValueString()
+	err := r.client.InTransaction(func(transactionID string) error {
+		return r.client.UpdateCacheInTransaction(ctx, transactionID, r.toPayload(&plan))
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating cache", fmt.Sprintf("Could not update cache %s: %s", name, err))
+		return
+	}
+
+	plan.ID = types.StringValue(name)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Delete deletes the cache section.
+func (r *cacheResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state cacheResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := state.Name.ValueString()
+	err := r.client.InTransaction(func(transactionID string) error {
+		return r.client.DeleteCacheInTransaction(ctx, transactionID, name)
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error deleting cache", fmt.Sprintf("Could not delete cache %s: %s", name, err))
+	}
+}
+
+// ImportState imports a cache section by name.
+func (r *cacheResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), req.ID)...)
+}
+
+// toPayload converts the resource model to a CachePayload.
+func (r *cacheResource) toPayload(model *cacheResourceModel) *CachePayload {
+	return &CachePayload{
+		Name:          model.Name.ValueString(),
+		TotalMaxSize:  model.TotalMaxSize.ValueInt64(),
+		MaxAge:        model.MaxAge.ValueInt64(),
+		MaxObjectSize: model.MaxObjectSize.ValueInt64(),
+		ProcessVary:   model.ProcessVary.ValueBoolPointer(),
+	}
+}
@@ -0,0 +1,409 @@
+package haproxy
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &defaultsResource{}
+	_ resource.ResourceWithConfigure   = &defaultsResource{}
+	_ resource.ResourceWithImportState = &defaultsResource{}
+)
+
+// NewDefaultsResource is a helper function to simplify the provider implementation.
+func NewDefaultsResource() resource.Resource {
+	return &defaultsResource{}
+}
+
+// defaultsResource manages a named defaults section with its log targets and HTTP rules.
+type defaultsResource struct {
+	client *HAProxyClient
+}
+
+// defaultsResourceModel maps the resource schema data.
+type defaultsResourceModel struct {
+	ID                   types.String                   `tfsdk:"id"`
+	Name                 types.String                   `tfsdk:"name"`
+	Mode                 types.String                   `tfsdk:"mode"`
+	Maxconn              types.Int64                    `tfsdk:"maxconn"`
+	Retries              types.Int64                    `tfsdk:"retries"`
+	HttpConnectionMode   types.String                   `tfsdk:"http_connection_mode"`
+	ClientTimeout        types.Int64                    `tfsdk:"client_timeout"`
+	ServerTimeout        types.Int64                    `tfsdk:"server_timeout"`
+	ConnectTimeout       types.Int64                    `tfsdk:"connect_timeout"`
+	QueueTimeout         types.Int64                    `tfsdk:"queue_timeout"`
+	TunnelTimeout        types.Int64                    `tfsdk:"tunnel_timeout"`
+	CheckTimeout         types.Int64                    `tfsdk:"check_timeout"`
+	HttpRequestTimeout   types.Int64                    `tfsdk:"http_request_timeout"`
+	HttpKeepAliveTimeout types.Int64                    `tfsdk:"http_keep_alive_timeout"`
+	Httplog              types.Bool                     `tfsdk:"httplog"`
+	Tcplog               types.Bool                     `tfsdk:"tcplog"`
+	Dontlognull          types.Bool                     `tfsdk:"dontlognull"`
+	LogSeparateErrors    types.Bool                     `tfsdk:"log_separate_errors"`
+	LogFormat            types.String                   `tfsdk:"log_format"`
+	Forwardfor           types.Bool                     `tfsdk:"forwardfor"`
+	ErrorFiles           []haproxyErrorFileModel        `tfsdk:"errorfile"`
+	LogTargets           []haproxyLogTargetModel        `tfsdk:"log_target"`
+	HttpRequestRules     []haproxyHttpRequestRuleModel  `tfsdk:"http_request_rules"`
+	HttpResponseRules    []haproxyHttpResponseRuleModel `tfsdk:"http_response_rules"`
+}
+
+// haproxyErrorFileModel maps the errorfile block schema data.
+type haproxyErrorFileModel struct {
+	Code types.Int64  `tfsdk:"code"`
+	File types.String `tfsdk:"file"`
+}
+
+// Metadata returns the resource type name.
+func (r *defaultsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_defaults"
+}
+
+// Schema defines the schema for the resource.
+func (r *defaultsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a named HAProxy defaults section. Frontends and backends inherit from it through the defaults attribute of an haproxy_stack.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The name of the defaults section.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the defaults section, referenced by the defaults attribute of frontends and backends.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"mode": schema.StringAttribute{
+				Optional:    true,
+				Description: "The default proxy mode (http, tcp).",
+			},
+			"maxconn": schema.Int64Attribute{
+				Optional:    true,
+				Description: "The default maximum number of concurrent connections per frontend.",
+			},
+			"retries": schema.Int64Attribute{
+				Optional:    true,
+				Description: "The number of retries after a connection failure to a server.",
+			},
+			"http_connection_mode": schema.StringAttribute{
+				Optional:    true,
+				Description: "The HTTP connection mode (httpclose, http-server-close, http-keep-alive).",
+			},
+			"client_timeout": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Client inactivity timeout in milliseconds.",
+			},
+			"server_timeout": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Server inactivity timeout in milliseconds.",
+			},
+			"connect_timeout": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Connection timeout in milliseconds.",
+			},
+			"queue_timeout": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Queue timeout in milliseconds.",
+			},
+			"tunnel_timeout": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Tunnel timeout in milliseconds.",
+			},
+			"check_timeout": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Health check timeout in milliseconds.",
+			},
+			"http_request_timeout": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Timeout for a complete HTTP request in milliseconds.",
+			},
+			"http_keep_alive_timeout": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Timeout for a new HTTP request on a kept-alive connection in milliseconds.",
+			},
+			"httplog": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether to use the HTTP log format (option httplog).",
+			},
+			"tcplog": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether to use the TCP log format (option tcplog).",
+			},
+			"dontlognull": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether to skip logging connections that carry no data (option dontlognull).",
+			},
+			"log_separate_errors": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether to raise the log level of requests that end in error (option log-separate-errors).",
+			},
+			"log_format": schema.StringAttribute{
+				Optional:    true,
+				Description: "A custom log format.",
+			},
+			"forwardfor": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether to add an X-Forwarded-For header to requests (option forwardfor).",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"errorfile": schema.ListNestedBlock{
+				Description: "A file returned instead of the built-in error page for a status code.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"code": schema.Int64Attribute{
+							Required:    true,
+							Description: "The HTTP status code (e.g. 503).",
+						},
+						"file": schema.StringAttribute{
+							Required:    true,
+							Description: "The path of the file on the HAProxy host.",
+						},
+					},
+				},
+			},
+			"log_target":          GetLogTargetSchema(),
+			"http_request_rules":  GetHttpRequestRuleSchema(),
+			"http_response_rules": GetHttpResponseRuleSchema(),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *defaultsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+// Create creates the defaults section with its log targets and HTTP rules in a single transaction.
+func (r *defaultsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan defaultsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := plan.Name.ValueString()
+	err := r.client.InTransaction(func(transactionID string) error {
+		if err := r.client.CreateDefaultsInTransaction(ctx, transactionID, r.toPayload(&plan)); err != nil {
+			return err
+		}
+		if len(plan.LogTargets) > 0 {
+			if err := r.client.CreateAllLogTargetsInTransaction(ctx, transactionID, "defaults", name, logTargetsToPayloads(plan.LogTargets)); err != nil {
+				return err
+			}
+		}
+		if err := CreateHttpRequestRuleManager(r.client).CreateHttpRequestRulesInTransaction(ctx, transactionID, "defaults", name, plan.HttpRequestRules); err != nil {
+			return err
+		}
+		return CreateHttpResponseRuleManager(r.client).CreateHttpResponseRulesInTransaction(ctx, transactionID, "defaults", name, plan.HttpResponseRules)
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating defaults", fmt.Sprintf("Could not create defaults %s: %s", name, err))
+		return
+	}
+
+	plan.ID = types.StringValue(name)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *defaultsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state defaultsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := state.Name.ValueString()
+	defaults, err := r.client.ReadDefaults(ctx, name)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading defaults", fmt.Sprintf("Could not read defaults %s: %s", name, err))
+		return
+	}
+	if defaults == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	logTargets, err := r.client.ReadLogTargets(ctx, "defaults", name)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading log targets", fmt.Sprintf("Could not read log targets of %s: %s", name, err))
+		return
+	}
+	httpRequestRules, err := r.client.ReadHttpRequestRules(ctx, "defaults", name)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading HTTP request rules", fmt.Sprintf("Could not read HTTP request rules of %s: %s", name, err))
+		return
+	}
+	httpResponseRules, err := r.client.ReadHttpResponseRules(ctx, "defaults", name)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading HTTP response rules", fmt.Sprintf("Could not read HTTP response rules of %s: %s", name, err))
+		return
+	}
+
+	state.ID = types.StringValue(name)
+	state.Mode = stringOrNull(defaults.Mode)
+	state.Maxconn = int64OrNull(defaults.Maxconn)
+	state.Retries = int64OrNull(defaults.Retries)
+	state.HttpConnectionMode = stringOrNull(defaults.HttpConnectionMode)
+	state.ClientTimeout = int64OrNull(defaults.ClientTimeout)
+	state.ServerTimeout = int64OrNull(defaults.ServerTimeout)
+	state.ConnectTimeout = int64OrNull(defaults.ConnectTimeout)
+	state.QueueTimeout = int64OrNull(defaults.QueueTimeout)
+	state.TunnelTimeout = int64OrNull(defaults.TunnelTimeout)
+	state.CheckTimeout = int64OrNull(defaults.CheckTimeout)
+	state.HttpRequestTimeout = int64OrNull(defaults.HttpRequestTimeout)
+	state.HttpKeepAliveTimeout = int64OrNull(defaults.HttpKeepAliveTimeout)
+	state.Httplog = boolOrPrior(defaults.Httplog, state.Httplog)
+	state.Tcplog = boolOrPrior(defaults.Tcplog, state.Tcplog)
+	state.Dontlognull = boolOrPrior(defaults.Dontlognull == "enabled", state.Dontlognull)
+	state.LogSeparateErrors = boolOrPrior(defaults.LogSeparateErrors == "enabled", state.LogSeparateErrors)
+	state.LogFormat = stringOrNull(defaults.LogFormat)
+	state.Forwardfor = boolOrPrior(defaults.Forwardfor != nil && defaults.Forwardfor.Enabled == "enabled", state.Forwardfor)
+	state.ErrorFiles = errorFilesFromPayloads(defaults.ErrorFiles)
+	state.LogTargets = logTargetsFromPayloads(logTargets)
+
+	state.HttpRequestRules = CreateHttpRequestRuleManager(r.client).refreshHttpRequestRules(state.HttpRequestRules, httpRequestRules)
+	state.HttpResponseRules = CreateHttpResponseRuleManager(r.client).refreshHttpResponseRules(state.HttpResponseRules, httpResponseRules)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Update updates the defaults section and replaces its log targets and HTTP rules in a single transaction.
+func (r *defaultsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state defaultsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := plan.Name.ValueString()
+	err := r.client.InTransaction(func(transactionID string) error {
+		if err := r.client.UpdateDefaultsInTransaction(ctx, transactionID, r.toPayload(&plan)); err != nil {
+			return err
+		}
+		// Only the lists that differ from the state are rewritten
+		if !reflect.DeepEqual(logTargetsToPayloads(plan.LogTargets), logTargetsToPayloads(state.LogTargets)) {
+			if err := r.client.ReplaceLogTargetsInTransaction(ctx, transactionID, "defaults", name, logTargetsToPayloads(plan.LogTargets)); err != nil {
+				return err
+			}
+		}
+		if len(plan.HttpRequestRules) > 0 || len(state.HttpRequestRules) > 0 {
+			if err := CreateHttpRequestRuleManager(r.client).UpdateHttpRequestRulesInTransaction(ctx, transactionID, "defaults", name, plan.HttpRequestRules, state.HttpRequestRules); err != nil {
+				return err
+			}
+		}
+		if len(plan.HttpResponseRules) > 0 || len(state.HttpResponseRules) > 0 {
+			return CreateHttpResponseRuleManager(r.client).UpdateHttpResponseRulesInTransaction(ctx, transactionID, "defaults", name, plan.HttpResponseRules, state.HttpResponseRules)
+		}
+		return nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating defaults", fmt.Sprintf("Could not update defaults %s: %s", name, err))
+		return
+	}
+
+	plan.ID = types.StringValue(name)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Delete deletes the defaults section; its log targets and rules are removed with it.
+func (r *defaultsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state defaultsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := state.Name.ValueString()
+	err := r.client.InTransaction(func(transactionID string) error {
+		return r.client.DeleteDefaultsInTransaction(ctx, transactionID, name)
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error deleting defaults", fmt.Sprintf("Could not delete defaults %s: %s", name, err))
+	}
+}
+
+// ImportState imports a defaults section by name.
+func (r *defaultsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), req.ID)...)
+}
+
+// toPayload converts the resource model to a DefaultsPayload.
+func (r *defaultsResource) toPayload(model *defaultsResourceModel) *DefaultsPayload {
+	payload := &DefaultsPayload{
+		Name:                 model.Name.ValueString(),
+		Mode:                 model.Mode.ValueString(),
+		Maxconn:              model.Maxconn.ValueInt64(),
+		Retries:              model.Retries.ValueInt64(),
+		HttpConnectionMode:   model.HttpConnectionMode.ValueString(),
+		ClientTimeout:        model.ClientTimeout.ValueInt64(),
+		ServerTimeout:        model.ServerTimeout.ValueInt64(),
+		ConnectTimeout:       model.ConnectTimeout.ValueInt64(),
+		QueueTimeout:         model.QueueTimeout.ValueInt64(),
+		TunnelTimeout:        model.TunnelTimeout.ValueInt64(),
+		CheckTimeout:         model.CheckTimeout.ValueInt64(),
+		HttpRequestTimeout:   model.HttpRequestTimeout.ValueInt64(),
+		HttpKeepAliveTimeout: model.HttpKeepAliveTimeout.ValueInt64(),
+		Httplog:              model.Httplog.ValueBool(),
+		Tcplog:               model.Tcplog.ValueBool(),
+		LogFormat:            model.LogFormat.ValueString(),
+	}
+	if model.Dontlognull.ValueBool() {
+		payload.Dontlognull = "enabled"
+	}
+	if model.LogSeparateErrors.ValueBool() {
+		payload.LogSeparateErrors = "enabled"
+	}
+	if model.Forwardfor.ValueBool() {
+		payload.Forwardfor = &ForwardFor{Enabled: "enabled"}
+	}
+	for _, errorFile := range model.ErrorFiles {
+		payload.ErrorFiles = append(payload.ErrorFiles, ErrorFilePayload{
+			Code: errorFile.Code.ValueInt64(),
+			File: errorFile.File.ValueString(),
+		})
+	}
+	return payload
+}
+
+// errorFilesFromPayloads converts errorfile payloads to errorfile blocks.
+func errorFilesFromPayloads(payloads []ErrorFilePayload) []haproxyErrorFileModel {
+	var errorFiles []haproxyErrorFileModel
+	for _, payload := range payloads {
+		errorFiles = append(errorFiles, haproxyErrorFileModel{
+			Code: types.Int64Value(payload.Code),
+			File: types.StringValue(payload.File),
+		})
+	}
+	return errorFiles
+}
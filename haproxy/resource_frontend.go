@@ -126,8 +126,19 @@ func (r *FrontendManager) ReadFrontend(ctx context.Context, frontendName string,
 	if frontend != nil && frontend.MonitorUri != "" {
 		frontendModel.MonitorUri = types.StringValue(frontend.MonitorUri)
 	}
+	if frontend != nil && frontend.From != "" {
+		frontendModel.Defaults = types.StringValue(frontend.From)
+	}
 	if frontend != nil {
 		frontendModel.MonitorFail = r.convertMonitorFailFromPayload(frontend.MonitorFail)
+		frontendModel.StatsOptions = convertStatsOptionsFromPayload(frontend.StatsOptions)
+		frontendModel.Compression = convertCompressionFromPayload(frontend.Compression)
+
+		var existingErrorFiles []haproxyErrorFilesModel
+		if existingFrontend != nil {
+			existingErrorFiles = existingFrontend.ErrorFiles
+		}
+		frontendModel.ErrorFiles = CreateErrorFilesManager(r.client).Read(ctx, frontend.ErrorFiles, frontend.ErrorFilesFromHttpErrors, existingErrorFiles)
 	}
 
 	// Handle ACLs - prioritize existing state to preserve user's exact order
@@ -142,7 +153,7 @@ func (r *FrontendManager) ReadFrontend(ctx context.Context, frontendName string,
 			aclModels = append(aclModels, haproxyAclModel{
 				AclName:   types.StringValue(acl.AclName),
 				Criterion: types.StringValue(acl.Criterion),
-				Value:     types.StringValue(acl.Value),
+				Value:     stringOrNull(acl.Value),
 			})
 		}
 		frontendModel.Acls = aclModels
@@ -253,7 +264,10 @@ func (r *FrontendManager) processFrontendBlock(frontend *haproxyFrontendModel) *
 		Backlog:        frontend.Backlog.ValueInt64(),
 		MonitorFail:    monitorFail,
 		MonitorUri:     frontend.MonitorUri.ValueString(),
+		From:           frontend.Defaults.ValueString(),
+		Compression:    processCompressionBlock(frontend.Compression),
 	}
+	payload.ErrorFiles, payload.ErrorFilesFromHttpErrors = CreateErrorFilesManager(r.client).processErrorFilesBlock(frontend.ErrorFiles)
 
 	log.Printf("DEBUG: processFrontendBlock - Final payload MonitorFail: %+v", payload.MonitorFail)
 	return payload
@@ -317,29 +331,5 @@ func (r *FrontendManager) formatHttpRequestRuleOrder(rules []haproxyHttpRequestR
 
 // convertHttpRequestRulePayloadToModel converts HAProxy API payload to Terraform model
 func (r *FrontendManager) convertHttpRequestRulePayloadToModel(payload *HttpRequestRulePayload) haproxyHttpRequestRuleModel {
-	model := haproxyHttpRequestRuleModel{
-		Type: types.StringValue(payload.Type),
-	}
-
-	// Set optional fields only if they have values
-	if payload.Cond != "" {
-		model.Cond = types.StringValue(payload.Cond)
-	}
-	if payload.CondTest != "" {
-		model.CondTest = types.StringValue(payload.CondTest)
-	}
-	if payload.HdrName != "" {
-		model.HdrName = types.StringValue(payload.HdrName)
-	}
-	if payload.HdrFormat != "" {
-		model.HdrFormat = types.StringValue(payload.HdrFormat)
-	}
-	if payload.RedirType != "" {
-		model.RedirType = types.StringValue(payload.RedirType)
-	}
-	if payload.RedirValue != "" {
-		model.RedirValue = types.StringValue(payload.RedirValue)
-	}
-
-	return model
+	return CreateHttpRequestRuleManager(r.client).convertFromHttpRequestRulePayload(payload)
 }
@@ -0,0 +1,668 @@
+package haproxy
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &globalResource{}
+	_ resource.ResourceWithConfigure   = &globalResource{}
+	_ resource.ResourceWithImportState = &globalResource{}
+)
+
+// globalResourceID is the fixed ID of the haproxy_global singleton.
+const globalResourceID = "global"
+
+// NewGlobalResource is a helper function to simplify the provider implementation.
+func NewGlobalResource() resource.Resource {
+	return &globalResource{}
+}
+
+// globalResource manages the settings of the global section it declares.
+// Settings left unset in the configuration are not touched.
+type globalResource struct {
+	client *HAProxyClient
+}
+
+// globalResourceModel maps the resource schema data.
+type globalResourceModel struct {
+	ID                           types.String                    `tfsdk:"id"`
+	Maxconn                      types.Int64                     `tfsdk:"maxconn"`
+	Nbthread                     types.Int64                     `tfsdk:"nbthread"`
+	StatsTimeout                 types.Int64                     `tfsdk:"stats_timeout"`
+	SslDefaultBindCiphers        types.String                    `tfsdk:"ssl_default_bind_ciphers"`
+	SslDefaultBindCiphersuites   types.String                    `tfsdk:"ssl_default_bind_ciphersuites"`
+	SslDefaultBindOptions        types.String                    `tfsdk:"ssl_default_bind_options"`
+	SslDefaultServerCiphers      types.String                    `tfsdk:"ssl_default_server_ciphers"`
+	SslDefaultServerCiphersuites types.String                    `tfsdk:"ssl_default_server_ciphersuites"`
+	SslDefaultServerOptions      types.String                    `tfsdk:"ssl_default_server_options"`
+	LuaLoads                     []types.String                  `tfsdk:"lua_loads"`
+	Tune                         *haproxyGlobalTuneModel         `tfsdk:"tune"`
+	StatsSockets                 []haproxyGlobalStatsSocketModel `tfsdk:"stats_socket"`
+	LogTargets                   []haproxyLogTargetModel         `tfsdk:"log_target"`
+}
+
+// haproxyGlobalTuneModel maps the tune block schema data.
+type haproxyGlobalTuneModel struct {
+	Bufsize           types.Int64 `tfsdk:"bufsize"`
+	Maxrewrite        types.Int64 `tfsdk:"maxrewrite"`
+	HttpMaxhdr        types.Int64 `tfsdk:"http_maxhdr"`
+	SslCachesize      types.Int64 `tfsdk:"ssl_cachesize"`
+	SslDefaultDhParam types.Int64 `tfsdk:"ssl_default_dh_param"`
+}
+
+// haproxyGlobalStatsSocketModel maps the stats_socket block schema data.
+type haproxyGlobalStatsSocketModel struct {
+	Address           types.String `tfsdk:"address"`
+	Level             types.String `tfsdk:"level"`
+	Mode              types.String `tfsdk:"mode"`
+	ExposeFdListeners types.Bool   `tfsdk:"expose_fd_listeners"`
+}
+
+// haproxyLogTargetModel maps the log_target block schema data.
+type haproxyLogTargetModel struct {
+	Address  types.String `tfsdk:"address"`
+	Facility types.String `tfsdk:"facility"`
+	Level    types.String `tfsdk:"level"`
+	Minlevel types.String `tfsdk:"minlevel"`
+	Format   types.String `tfsdk:"format"`
+	Length   types.Int64  `tfsdk:"length"`
+}
+
+// Metadata returns the resource type name.
+func (r *globalResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_global"
+}
+
+// Schema defines the schema for the resource.
+func (r *globalResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages settings of the HAProxy global section. Only one haproxy_global should exist per HAProxy instance. Settings left unset are not managed and keep their current value.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Always \"global\".",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"maxconn": schema.Int64Attribute{
+				Optional:    true,
+				Description: "The maximum number of concurrent connections per process.",
+			},
+			"nbthread": schema.Int64Attribute{
+				Optional:    true,
+				Description: "The number of threads to start.",
+			},
+			"stats_timeout": schema.Int64Attribute{
+				Optional:    true,
+				Description: "The timeout on the stats socket, in milliseconds.",
+			},
+			"ssl_default_bind_ciphers": schema.StringAttribute{
+				Optional:    true,
+				Description: "The default TLSv1.2 and lower cipher list for binds.",
+			},
+			"ssl_default_bind_ciphersuites": schema.StringAttribute{
+				Optional:    true,
+				Description: "The default TLSv1.3 cipher suites for binds.",
+			},
+			"ssl_default_bind_options": schema.StringAttribute{
+				Optional:    true,
+				Description: "The default SSL options for binds (e.g. \"ssl-min-ver TLSv1.2 no-tls-tickets\").",
+			},
+			"ssl_default_server_ciphers": schema.StringAttribute{
+				Optional:    true,
+				Description: "The default TLSv1.2 and lower cipher list for servers.",
+			},
+			"ssl_default_server_ciphersuites": schema.StringAttribute{
+				Optional:    true,
+				Description: "The default TLSv1.3 cipher suites for servers.",
+			},
+			"ssl_default_server_options": schema.StringAttribute{
+				Optional:    true,
+				Description: "The default SSL options for servers.",
+			},
+			"lua_loads": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Lua files to load (lua-load), in order.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"tune": schema.SingleNestedBlock{
+				Description: "tune.* settings.",
+				Attributes: map[string]schema.Attribute{
+					"bufsize": schema.Int64Attribute{
+						Optional:    true,
+						Description: "The buffer size, in bytes (tune.bufsize).",
+					},
+					"maxrewrite": schema.Int64Attribute{
+						Optional:    true,
+						Description: "The space reserved in buffers for header rewriting, in bytes (tune.maxrewrite).",
+					},
+					"http_maxhdr": schema.Int64Attribute{
+						Optional:    true,
+						Description: "The maximum number of headers in a request (tune.http.maxhdr).",
+					},
+					"ssl_cachesize": schema.Int64Attribute{
+						Optional:    true,
+						Description: "The number of entries in the SSL session cache (tune.ssl.cachesize).",
+					},
+					"ssl_default_dh_param": schema.Int64Attribute{
+						Optional:    true,
+						Description: "The maximum size of the Diffie-Hellman parameters, in bits (tune.ssl.default-dh-param).",
+					},
+				},
+			},
+			"stats_socket": schema.ListNestedBlock{
+				Description: "Runtime API sockets (stats socket). When no block is declared the existing sockets are left untouched, since the Data Plane API may rely on them.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"address": schema.StringAttribute{
+							Required:    true,
+							Description: "The socket address, e.g. /var/run/haproxy.sock or ipv4@127.0.0.1:9999.",
+						},
+						"level": schema.StringAttribute{
+							Optional:    true,
+							Description: "The privilege level (user, operator, admin).",
+						},
+						"mode": schema.StringAttribute{
+							Optional:    true,
+							Description: "The octal mode of a UNIX socket, e.g. 660.",
+						},
+						"expose_fd_listeners": schema.BoolAttribute{
+							Optional:    true,
+							Description: "Whether to expose listener file descriptors for seamless reloads.",
+						},
+					},
+				},
+			},
+			"log_target": GetLogTargetSchema(),
+		},
+	}
+}
+
+// GetLogTargetSchema returns the schema for the log_target block
+func GetLogTargetSchema() schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		Description: "Log targets, in order. When no block is declared the existing log targets are left untouched.",
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"address": schema.StringAttribute{
+					Required:    true,
+					Description: "The log destination, e.g. 127.0.0.1:514, /dev/log or stdout.",
+				},
+				"facility": schema.StringAttribute{
+					Optional:    true,
+					Description: "The syslog facility (e.g. local0).",
+				},
+				"level": schema.StringAttribute{
+					Optional:    true,
+					Description: "The maximum level of messages sent (e.g. info).",
+				},
+				"minlevel": schema.StringAttribute{
+					Optional:    true,
+					Description: "The minimum level of messages sent.",
+				},
+				"format": schema.StringAttribute{
+					Optional:    true,
+					Description: "The log format (e.g. rfc5424, raw).",
+				},
+				"length": schema.Int64Attribute{
+					Optional:    true,
+					Description: "The maximum line length.",
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *globalResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+// Create takes over the declared settings of the global section.
+func (r *globalResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan globalResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.apply(ctx, &globalResourceModel{}, &plan); err != nil {
+		resp.Diagnostics.AddError("Error configuring global section", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(globalResourceID)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Read refreshes the managed settings from HAProxy so that out-of-band edits show up as drift.
+// Settings the state does not manage are never adopted, even when the state manages nothing.
+func (r *globalResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state globalResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, err := r.readCurrent(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading global section", err.Error())
+		return
+	}
+	current.restrictTo(&state)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, current)...)
+}
+
+// Update applies the changed settings; settings removed from the configuration are removed from HAProxy.
+func (r *globalResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state globalResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.apply(ctx, &state, &plan); err != nil {
+		resp.Diagnostics.AddError("Error updating global section", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(globalResourceID)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Delete removes the managed settings from the global section. The section itself always exists.
+func (r *globalResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state globalResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.apply(ctx, &state, &globalResourceModel{}); err != nil {
+		resp.Diagnostics.AddError("Error resetting global section", err.Error())
+	}
+}
+
+// ImportState imports the global section. Every setting currently configured is adopted.
+func (r *globalResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	current, err := r.readCurrent(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading global section", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, current)...)
+}
+
+// readCurrent returns every setting of the global section and its log targets.
+func (r *globalResource) readCurrent(ctx context.Context) (globalResourceModel, error) {
+	global, err := r.client.ReadGlobal(ctx)
+	if err != nil {
+		return globalResourceModel{}, err
+	}
+	if global == nil {
+		global = &GlobalPayload{}
+	}
+	logTargets, err := r.client.ReadLogTargets(ctx, "global", "")
+	if err != nil {
+		return globalResourceModel{}, fmt.Errorf("failed to read global log targets: %w", err)
+	}
+
+	current := globalModelFromPayload(global, logTargets)
+	current.ID = types.StringValue(globalResourceID)
+	return current, nil
+}
+
+// apply moves the global section from the previous to the desired settings in one transaction.
+func (r *globalResource) apply(ctx context.Context, previous, desired *globalResourceModel) error {
+	apiVersion := r.client.apiVersion
+	return r.client.InTransaction(func(transactionID string) error {
+		section, err := r.client.ReadGlobalSection(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to read global section: %w", err)
+		}
+		if err := mergeGlobalSection(section, previous.toPayload(apiVersion), desired.toPayload(apiVersion)); err != nil {
+			return err
+		}
+		if err := r.client.ReplaceGlobalInTransaction(ctx, transactionID, section); err != nil {
+			return err
+		}
+
+		if len(previous.LogTargets) == 0 && len(desired.LogTargets) == 0 {
+			return nil
+		}
+		return r.client.ReplaceLogTargetsInTransaction(ctx, transactionID, "global", "", logTargetsToPayloads(desired.LogTargets))
+	})
+}
+
+// mergeGlobalSection writes desired into the raw global section. Keys set in previous but
+// not in desired are removed; keys set in neither are left as they are. Nested objects
+// such as tune_options are merged one level deep so unmanaged tune.* settings survive.
+func mergeGlobalSection(section map[string]interface{}, previous, desired *GlobalPayload) error {
+	previousFields, err := payloadToMap(previous)
+	if err != nil {
+		return err
+	}
+	desiredFields, err := payloadToMap(desired)
+	if err != nil {
+		return err
+	}
+
+	keys := make(map[string]bool, len(previousFields)+len(desiredFields))
+	for key := range previousFields {
+		keys[key] = true
+	}
+	for key := range desiredFields {
+		keys[key] = true
+	}
+
+	for key := range keys {
+		previousValue, _ := previousFields[key].(map[string]interface{})
+		desiredValue, isObject := desiredFields[key].(map[string]interface{})
+		if previousValue == nil && !isObject {
+			if value, ok := desiredFields[key]; ok {
+				section[key] = value
+			} else {
+				delete(section, key)
+			}
+			continue
+		}
+
+		nested, _ := section[key].(map[string]interface{})
+		if nested == nil {
+			nested = map[string]interface{}{}
+		}
+		for subKey := range previousValue {
+			delete(nested, subKey)
+		}
+		for subKey, value := range desiredValue {
+			nested[subKey] = value
+		}
+		if len(nested) == 0 {
+			delete(section, key)
+		} else {
+			section[key] = nested
+		}
+	}
+	return nil
+}
+
+// payloadToMap returns the JSON fields set in payload.
+func payloadToMap(payload interface{}) (map[string]interface{}, error) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+	fields := map[string]interface{}{}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	return fields, nil
+}
+
+// restrictTo nulls the settings that the state does not manage.
+func (m *globalResourceModel) restrictTo(state *globalResourceModel) {
+	keep := func(value types.Int64, managed types.Int64) types.Int64 {
+		if managed.IsNull() {
+			return types.Int64Null()
+		}
+		return value
+	}
+	keepString := func(value types.String, managed types.String) types.String {
+		if managed.IsNull() {
+			return types.StringNull()
+		}
+		return value
+	}
+
+	m.Maxconn = keep(m.Maxconn, state.Maxconn)
+	m.Nbthread = keep(m.Nbthread, state.Nbthread)
+	m.StatsTimeout = keep(m.StatsTimeout, state.StatsTimeout)
+	m.SslDefaultBindCiphers = keepString(m.SslDefaultBindCiphers, state.SslDefaultBindCiphers)
+	m.SslDefaultBindCiphersuites = keepString(m.SslDefaultBindCiphersuites, state.SslDefaultBindCiphersuites)
+	m.SslDefaultBindOptions = keepString(m.SslDefaultBindOptions, state.SslDefaultBindOptions)
+	m.SslDefaultServerCiphers = keepString(m.SslDefaultServerCiphers, state.SslDefaultServerCiphers)
+	m.SslDefaultServerCiphersuites = keepString(m.SslDefaultServerCiphersuites, state.SslDefaultServerCiphersuites)
+	m.SslDefaultServerOptions = keepString(m.SslDefaultServerOptions, state.SslDefaultServerOptions)
+
+	if state.Tune == nil {
+		m.Tune = nil
+	} else {
+		if m.Tune == nil {
+			m.Tune = &haproxyGlobalTuneModel{}
+		}
+		m.Tune.Bufsize = keep(m.Tune.Bufsize, state.Tune.Bufsize)
+		m.Tune.Maxrewrite = keep(m.Tune.Maxrewrite, state.Tune.Maxrewrite)
+		m.Tune.HttpMaxhdr = keep(m.Tune.HttpMaxhdr, state.Tune.HttpMaxhdr)
+		m.Tune.SslCachesize = keep(m.Tune.SslCachesize, state.Tune.SslCachesize)
+		m.Tune.SslDefaultDhParam = keep(m.Tune.SslDefaultDhParam, state.Tune.SslDefaultDhParam)
+	}
+
+	if len(state.LuaLoads) == 0 {
+		m.LuaLoads = state.LuaLoads
+	}
+	if len(state.StatsSockets) == 0 {
+		m.StatsSockets = state.StatsSockets
+	}
+	if len(state.LogTargets) == 0 {
+		m.LogTargets = state.LogTargets
+	}
+}
+
+// toPayload converts the model to a GlobalPayload in the layout of the given API version.
+func (m *globalResourceModel) toPayload(apiVersion string) *GlobalPayload {
+	payload := &GlobalPayload{
+		Maxconn:      m.Maxconn.ValueInt64(),
+		Nbthread:     m.Nbthread.ValueInt64(),
+		StatsTimeout: m.StatsTimeout.ValueInt64(),
+	}
+
+	for _, socket := range m.StatsSockets {
+		payload.RuntimeAPIs = append(payload.RuntimeAPIs, GlobalRuntimeAPI{
+			Address:           socket.Address.ValueString(),
+			Level:             socket.Level.ValueString(),
+			Mode:              socket.Mode.ValueString(),
+			ExposeFdListeners: socket.ExposeFdListeners.ValueBool(),
+		})
+	}
+
+	var luaLoads []GlobalLuaLoad
+	for _, file := range m.LuaLoads {
+		luaLoads = append(luaLoads, GlobalLuaLoad{File: file.ValueString()})
+	}
+
+	tune := m.Tune
+	if tune == nil {
+		tune = &haproxyGlobalTuneModel{}
+	}
+
+	if apiVersion == "v3" {
+		ssl := GlobalSslOptions{
+			DefaultBindCiphers:        m.SslDefaultBindCiphers.ValueString(),
+			DefaultBindCiphersuites:   m.SslDefaultBindCiphersuites.ValueString(),
+			DefaultBindOptions:        m.SslDefaultBindOptions.ValueString(),
+			DefaultServerCiphers:      m.SslDefaultServerCiphers.ValueString(),
+			DefaultServerCiphersuites: m.SslDefaultServerCiphersuites.ValueString(),
+			DefaultServerOptions:      m.SslDefaultServerOptions.ValueString(),
+		}
+		if ssl != (GlobalSslOptions{}) {
+			payload.SslOptions = &ssl
+		}
+		if tune.HttpMaxhdr.ValueInt64() != 0 {
+			payload.TuneOptions = &GlobalTuneOptions{HttpMaxhdr: tune.HttpMaxhdr.ValueInt64()}
+		}
+		buffer := GlobalTuneBufferOptions{
+			Bufsize:    tune.Bufsize.ValueInt64(),
+			Maxrewrite: tune.Maxrewrite.ValueInt64(),
+		}
+		if buffer != (GlobalTuneBufferOptions{}) {
+			payload.TuneBufferOptions = &buffer
+		}
+		tuneSsl := GlobalTuneSslOptions{
+			Cachesize:      tune.SslCachesize.ValueInt64(),
+			DefaultDhParam: tune.SslDefaultDhParam.ValueInt64(),
+		}
+		if tuneSsl != (GlobalTuneSslOptions{}) {
+			payload.TuneSslOptions = &tuneSsl
+		}
+		if len(luaLoads) > 0 {
+			payload.LuaOptions = &GlobalLuaOptions{Loads: luaLoads}
+		}
+		return payload
+	}
+
+	// v2: flat fields
+	payload.SslDefaultBindCiphers = m.SslDefaultBindCiphers.ValueString()
+	payload.SslDefaultBindCiphersuites = m.SslDefaultBindCiphersuites.ValueString()
+	payload.SslDefaultBindOptions = m.SslDefaultBindOptions.ValueString()
+	payload.SslDefaultServerCiphers = m.SslDefaultServerCiphers.ValueString()
+	payload.SslDefaultServerCiphersuites = m.SslDefaultServerCiphersuites.ValueString()
+	payload.SslDefaultServerOptions = m.SslDefaultServerOptions.ValueString()
+	payload.TuneSslDefaultDhParam = tune.SslDefaultDhParam.ValueInt64()
+	tuneOptions := GlobalTuneOptions{
+		Bufsize:      tune.Bufsize.ValueInt64(),
+		Maxrewrite:   tune.Maxrewrite.ValueInt64(),
+		HttpMaxhdr:   tune.HttpMaxhdr.ValueInt64(),
+		SslCachesize: tune.SslCachesize.ValueInt64(),
+	}
+	if tuneOptions != (GlobalTuneOptions{}) {
+		payload.TuneOptions = &tuneOptions
+	}
+	payload.LuaLoads = luaLoads
+	return payload
+}
+
+// globalModelFromPayload converts a GlobalPayload of either API version to a model.
+func globalModelFromPayload(global *GlobalPayload, logTargets []LogTargetPayload) globalResourceModel {
+	ssl := GlobalSslOptions{
+		DefaultBindCiphers:        global.SslDefaultBindCiphers,
+		DefaultBindCiphersuites:   global.SslDefaultBindCiphersuites,
+		DefaultBindOptions:        global.SslDefaultBindOptions,
+		DefaultServerCiphers:      global.SslDefaultServerCiphers,
+		DefaultServerCiphersuites: global.SslDefaultServerCiphersuites,
+		DefaultServerOptions:      global.SslDefaultServerOptions,
+	}
+	if global.SslOptions != nil {
+		ssl = *global.SslOptions
+	}
+
+	var tuneOptions GlobalTuneOptions
+	if global.TuneOptions != nil {
+		tuneOptions = *global.TuneOptions
+	}
+	if global.TuneBufferOptions != nil {
+		tuneOptions.Bufsize = global.TuneBufferOptions.Bufsize
+		tuneOptions.Maxrewrite = global.TuneBufferOptions.Maxrewrite
+	}
+	dhParam := global.TuneSslDefaultDhParam
+	if global.TuneSslOptions != nil {
+		tuneOptions.SslCachesize = global.TuneSslOptions.Cachesize
+		dhParam = global.TuneSslOptions.DefaultDhParam
+	}
+
+	model := globalResourceModel{
+		Maxconn:                      int64OrNull(global.Maxconn),
+		Nbthread:                     int64OrNull(global.Nbthread),
+		StatsTimeout:                 int64OrNull(global.StatsTimeout),
+		SslDefaultBindCiphers:        stringOrNull(ssl.DefaultBindCiphers),
+		SslDefaultBindCiphersuites:   stringOrNull(ssl.DefaultBindCiphersuites),
+		SslDefaultBindOptions:        stringOrNull(ssl.DefaultBindOptions),
+		SslDefaultServerCiphers:      stringOrNull(ssl.DefaultServerCiphers),
+		SslDefaultServerCiphersuites: stringOrNull(ssl.DefaultServerCiphersuites),
+		SslDefaultServerOptions:      stringOrNull(ssl.DefaultServerOptions),
+		LogTargets:                   logTargetsFromPayloads(logTargets),
+	}
+
+	if tuneOptions != (GlobalTuneOptions{}) || dhParam != 0 {
+		model.Tune = &haproxyGlobalTuneModel{
+			Bufsize:           int64OrNull(tuneOptions.Bufsize),
+			Maxrewrite:        int64OrNull(tuneOptions.Maxrewrite),
+			HttpMaxhdr:        int64OrNull(tuneOptions.HttpMaxhdr),
+			SslCachesize:      int64OrNull(tuneOptions.SslCachesize),
+			SslDefaultDhParam: int64OrNull(dhParam),
+		}
+	}
+
+	luaLoads := global.LuaLoads
+	if global.LuaOptions != nil {
+		luaLoads = global.LuaOptions.Loads
+	}
+	for _, load := range luaLoads {
+		model.LuaLoads = append(model.LuaLoads, types.StringValue(load.File))
+	}
+
+	for _, api := range global.RuntimeAPIs {
+		socket := haproxyGlobalStatsSocketModel{
+			Address:           types.StringValue(api.Address),
+			Level:             stringOrNull(api.Level),
+			Mode:              stringOrNull(api.Mode),
+			ExposeFdListeners: types.BoolNull(),
+		}
+		if api.ExposeFdListeners {
+			socket.ExposeFdListeners = types.BoolValue(true)
+		}
+		model.StatsSockets = append(model.StatsSockets, socket)
+	}
+
+	return model
+}
+
+// logTargetsToPayloads converts log_target blocks to payloads, indexed by position.
+func logTargetsToPayloads(logTargets []haproxyLogTargetModel) []LogTargetPayload {
+	payloads := make([]LogTargetPayload, 0, len(logTargets))
+	for i, logTarget := range logTargets {
+		payloads = append(payloads, LogTargetPayload{
+			Index:    int64(i),
+			Address:  logTarget.Address.ValueString(),
+			Facility: logTarget.Facility.ValueString(),
+			Level:    logTarget.Level.ValueString(),
+			Minlevel: logTarget.Minlevel.ValueString(),
+			Format:   logTarget.Format.ValueString(),
+			Length:   logTarget.Length.ValueInt64(),
+		})
+	}
+	return payloads
+}
+
+// logTargetsFromPayloads converts log target payloads to log_target blocks.
+func logTargetsFromPayloads(payloads []LogTargetPayload) []haproxyLogTargetModel {
+	var logTargets []haproxyLogTargetModel
+	for _, payload := range payloads {
+		logTargets = append(logTargets, haproxyLogTargetModel{
+			Address:  types.StringValue(payload.Address),
+			Facility: stringOrNull(payload.Facility),
+			Level:    stringOrNull(payload.Level),
+			Minlevel: stringOrNull(payload.Minlevel),
+			Format:   stringOrNull(payload.Format),
+			Length:   int64OrNull(payload.Length),
+		})
+	}
+	return logTargets
+}
@@ -13,7 +13,9 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource = &haproxyStackResource{}
+	_ resource.Resource                   = &haproxyStackResource{}
+	_ resource.ResourceWithImportState    = &haproxyStackResource{}
+	_ resource.ResourceWithValidateConfig = &haproxyStackResource{}
 )
 
 // NewHaproxyStackResource is a helper function to simplify the provider implementation.
@@ -29,39 +31,55 @@ type haproxyStackResource struct {
 
 // haproxyStackResourceModel maps the resource schema data.
 type haproxyStackResourceModel struct {
-	Name     types.String          `tfsdk:"name"`
-	Backend  *haproxyBackendModel  `tfsdk:"backend"`
-	Frontend *haproxyFrontendModel `tfsdk:"frontend"`
+	Name           types.String                `tfsdk:"name"`
+	Backend        *haproxyBackendModel        `tfsdk:"backend"`
+	Frontend       *haproxyFrontendModel       `tfsdk:"frontend"`
+	WaitForHealthy *haproxyWaitForHealthyModel `tfsdk:"wait_for_healthy"`
+}
+
+// haproxyWaitForHealthyModel maps the wait_for_healthy block schema data.
+type haproxyWaitForHealthyModel struct {
+	MinHealthy types.Int64 `tfsdk:"min_healthy"`
+	Timeout    types.Int64 `tfsdk:"timeout"`
 }
 
 // haproxyBackendModel maps the backend block schema data.
 type haproxyBackendModel struct {
-	Name               types.String                   `tfsdk:"name"`
-	Mode               types.String                   `tfsdk:"mode"`
-	AdvCheck           types.String                   `tfsdk:"adv_check"`
-	HttpConnectionMode types.String                   `tfsdk:"http_connection_mode"`
-	ServerTimeout      types.Int64                    `tfsdk:"server_timeout"`
-	CheckTimeout       types.Int64                    `tfsdk:"check_timeout"`
-	ConnectTimeout     types.Int64                    `tfsdk:"connect_timeout"`
-	QueueTimeout       types.Int64                    `tfsdk:"queue_timeout"`
-	TunnelTimeout      types.Int64                    `tfsdk:"tunnel_timeout"`
-	TarpitTimeout      types.Int64                    `tfsdk:"tarpit_timeout"`
-	Checkcache         types.String                   `tfsdk:"checkcache"`
-	Servers            map[string]haproxyServerModel  `tfsdk:"servers"` // Multiple servers
-	Retries            types.Int64                    `tfsdk:"retries"`
-	Balance            []haproxyBalanceModel          `tfsdk:"balance"`
-	HttpchkParams      []haproxyHttpchkParamsModel    `tfsdk:"httpchk_params"`
-	Forwardfor         []haproxyForwardforModel       `tfsdk:"forwardfor"`
-	Httpchecks         []haproxyHttpcheckModel        `tfsdk:"http_checks"`
-	TcpChecks          []haproxyTcpCheckModel         `tfsdk:"tcp_checks"`
-	Acls               []haproxyAclModel              `tfsdk:"acls"`
-	HttpRequestRules   []haproxyHttpRequestRuleModel  `tfsdk:"http_request_rules"`
-	HttpResponseRules  []haproxyHttpResponseRuleModel `tfsdk:"http_response_rules"`
-	TcpRequestRules    []haproxyTcpRequestRuleModel   `tfsdk:"tcp_request_rules"`
-	TcpResponseRules   []haproxyTcpResponseRuleModel  `tfsdk:"tcp_response_rules"`
-	DefaultServer      *haproxyDefaultServerModel     `tfsdk:"default_server"`
-	StickTable         *haproxyStickTableModel        `tfsdk:"stick_table"`
-	StatsOptions       []haproxyStatsOptionsModel     `tfsdk:"stats_options"`
+	Name                   types.String                        `tfsdk:"name"`
+	Mode                   types.String                        `tfsdk:"mode"`
+	AdvCheck               types.String                        `tfsdk:"adv_check"`
+	HttpConnectionMode     types.String                        `tfsdk:"http_connection_mode"`
+	ServerTimeout          types.Int64                         `tfsdk:"server_timeout"`
+	CheckTimeout           types.Int64                         `tfsdk:"check_timeout"`
+	ConnectTimeout         types.Int64                         `tfsdk:"connect_timeout"`
+	QueueTimeout           types.Int64                         `tfsdk:"queue_timeout"`
+	TunnelTimeout          types.Int64                         `tfsdk:"tunnel_timeout"`
+	TarpitTimeout          types.Int64                         `tfsdk:"tarpit_timeout"`
+	Checkcache             types.String                        `tfsdk:"checkcache"`
+	Servers                map[string]haproxyServerModel       `tfsdk:"servers"` // Multiple servers
+	Retries                types.Int64                         `tfsdk:"retries"`
+	Defaults               types.String                        `tfsdk:"defaults"`
+	Balance                []haproxyBalanceModel               `tfsdk:"balance"`
+	HttpchkParams          []haproxyHttpchkParamsModel         `tfsdk:"httpchk_params"`
+	Forwardfor             []haproxyForwardforModel            `tfsdk:"forwardfor"`
+	Httpchecks             []haproxyHttpcheckModel             `tfsdk:"http_checks"`
+	TcpChecks              []haproxyTcpCheckModel              `tfsdk:"tcp_checks"`
+	Acls                   []haproxyAclModel                   `tfsdk:"acls"`
+	HttpRequestRules       []haproxyHttpRequestRuleModel       `tfsdk:"http_request_rules"`
+	HttpResponseRules      []haproxyHttpResponseRuleModel      `tfsdk:"http_response_rules"`
+	HttpAfterResponseRules []haproxyHttpAfterResponseRuleModel `tfsdk:"http_after_response_rules"`
+	TcpRequestRules        []haproxyTcpRequestRuleModel        `tfsdk:"tcp_request_rules"`
+	TcpResponseRules       []haproxyTcpResponseRuleModel       `tfsdk:"tcp_response_rules"`
+	StickRules             []haproxyStickRuleModel             `tfsdk:"stick_rules"`
+	ServerSwitchingRules   []haproxyServerSwitchingRuleModel   `tfsdk:"server_switching_rules"`
+	DefaultServer          *haproxyDefaultServerModel          `tfsdk:"default_server"`
+	StickTable             *haproxyStickTableModel             `tfsdk:"stick_table"`
+	StatsOptions           []haproxyStatsOptionsModel          `tfsdk:"stats_options"`
+	EmailAlert             *haproxyEmailAlertModel             `tfsdk:"email_alert"`
+	Compression            *haproxyCompressionModel            `tfsdk:"compression"`
+	Filters                []haproxyFilterModel                `tfsdk:"filters"`
+	ErrorFiles             []haproxyErrorFilesModel            `tfsdk:"error_files"`
+	DrainBeforeDelete      *haproxyDrainBeforeDeleteModel      `tfsdk:"drain_before_delete"`
 }
 
 // haproxyDefaultServerModel maps the default_server block schema data.
@@ -115,6 +133,53 @@ type haproxyServerModel struct {
 	Verify         types.String `tfsdk:"verify"`
 	Cookie         types.String `tfsdk:"cookie"`
 
+	// Limits, lifecycle and error handling
+	Maxqueue        types.Int64  `tfsdk:"maxqueue"`
+	Minconn         types.Int64  `tfsdk:"minconn"`
+	Slowstart       types.Int64  `tfsdk:"slowstart"`
+	InitAddr        types.String `tfsdk:"init_addr"`
+	Maintenance     types.String `tfsdk:"maintenance"`
+	Track           types.String `tfsdk:"track"`
+	Observe         types.String `tfsdk:"observe"`
+	ErrorLimit      types.Int64  `tfsdk:"error_limit"`
+	OnError         types.String `tfsdk:"on_error"`
+	OnMarkedDown    types.String `tfsdk:"on_marked_down"`
+	OnMarkedUp      types.String `tfsdk:"on_marked_up"`
+	HealthCheckPort types.Int64  `tfsdk:"health_check_port"`
+	// Agent checks
+	AgentCheck types.String `tfsdk:"agent_check"`
+	AgentAddr  types.String `tfsdk:"agent_addr"`
+	AgentPort  types.Int64  `tfsdk:"agent_port"`
+	AgentInter types.Int64  `tfsdk:"agent_inter"`
+	AgentSend  types.String `tfsdk:"agent_send"`
+	// Protocol and TLS
+	Proto        types.String `tfsdk:"proto"`
+	Alpn         types.String `tfsdk:"alpn"`
+	Sni          types.String `tfsdk:"sni"`
+	Verifyhost   types.String `tfsdk:"verifyhost"`
+	Ciphers      types.String `tfsdk:"ciphers"`
+	Ciphersuites types.String `tfsdk:"ciphersuites"`
+	SslReuse     types.String `tfsdk:"ssl_reuse"`
+	TlsTickets   types.String `tfsdk:"tls_tickets"`
+	CheckSsl     types.String `tfsdk:"check_ssl"`
+	CheckSni     types.String `tfsdk:"check_sni"`
+	CheckAlpn    types.String `tfsdk:"check_alpn"`
+	// PROXY protocol
+	SendProxy        types.String   `tfsdk:"send_proxy"`
+	SendProxyV2      types.String   `tfsdk:"send_proxy_v2"`
+	SendProxyV2Ssl   types.String   `tfsdk:"send_proxy_v2_ssl"`
+	SendProxyV2SslCn types.String   `tfsdk:"send_proxy_v2_ssl_cn"`
+	ProxyV2Options   []types.String `tfsdk:"proxy_v2_options"`
+	// Connection pools and miscellaneous
+	PoolLowConn    types.Int64  `tfsdk:"pool_low_conn"`
+	PoolMaxConn    types.Int64  `tfsdk:"pool_max_conn"`
+	PoolPurgeDelay types.Int64  `tfsdk:"pool_purge_delay"`
+	Source         types.String `tfsdk:"source"`
+	Redir          types.String `tfsdk:"redir"`
+	Stick          types.String `tfsdk:"stick"`
+	Tfo            types.String `tfsdk:"tfo"`
+	LogProto       types.String `tfsdk:"log_proto"`
+
 	// SSL/TLS Protocol Control (v3 fields)
 	Sslv3  types.String `tfsdk:"sslv3"`
 	Tlsv10 types.String `tfsdk:"tlsv10"`
@@ -137,33 +202,39 @@ type haproxyServerModel struct {
 
 // haproxyFrontendModel maps the frontend block schema data.
 type haproxyFrontendModel struct {
-	Name              types.String                   `tfsdk:"name"`
-	Mode              types.String                   `tfsdk:"mode"`
-	DefaultBackend    types.String                   `tfsdk:"default_backend"`
-	Maxconn           types.Int64                    `tfsdk:"maxconn"`
-	Backlog           types.Int64                    `tfsdk:"backlog"`
-	Ssl               types.Bool                     `tfsdk:"ssl"`
-	SslCertificate    types.String                   `tfsdk:"ssl_certificate"`
-	SslCafile         types.String                   `tfsdk:"ssl_cafile"`
-	SslMaxVer         types.String                   `tfsdk:"ssl_max_ver"`
-	SslMinVer         types.String                   `tfsdk:"ssl_min_ver"`
-	Ciphers           types.String                   `tfsdk:"ciphers"`
-	Ciphersuites      types.String                   `tfsdk:"ciphersuites"`
-	Verify            types.String                   `tfsdk:"verify"`
-	AcceptProxy       types.Bool                     `tfsdk:"accept_proxy"`
-	DeferAccept       types.Bool                     `tfsdk:"defer_accept"`
-	TcpUserTimeout    types.Int64                    `tfsdk:"tcp_user_timeout"`
-	Tfo               types.Bool                     `tfsdk:"tfo"`
-	V4v6              types.Bool                     `tfsdk:"v4v6"`
-	V6only            types.Bool                     `tfsdk:"v6only"`
-	MonitorUri        types.String                   `tfsdk:"monitor_uri"`
-	Binds             map[string]haproxyBindModel    `tfsdk:"binds"`
-	Acls              []haproxyAclModel              `tfsdk:"acls"`
-	HttpRequestRules  []haproxyHttpRequestRuleModel  `tfsdk:"http_request_rules"`
-	HttpResponseRules []haproxyHttpResponseRuleModel `tfsdk:"http_response_rules"`
-	TcpRequestRules   []haproxyTcpRequestRuleModel   `tfsdk:"tcp_request_rules"`
-	StatsOptions      []haproxyStatsOptionsModel     `tfsdk:"stats_options"`
-	MonitorFail       []haproxyMonitorFailModel      `tfsdk:"monitor_fail"`
+	Name                   types.String                        `tfsdk:"name"`
+	Mode                   types.String                        `tfsdk:"mode"`
+	DefaultBackend         types.String                        `tfsdk:"default_backend"`
+	Maxconn                types.Int64                         `tfsdk:"maxconn"`
+	Backlog                types.Int64                         `tfsdk:"backlog"`
+	Ssl                    types.Bool                          `tfsdk:"ssl"`
+	SslCertificate         types.String                        `tfsdk:"ssl_certificate"`
+	SslCafile              types.String                        `tfsdk:"ssl_cafile"`
+	SslMaxVer              types.String                        `tfsdk:"ssl_max_ver"`
+	SslMinVer              types.String                        `tfsdk:"ssl_min_ver"`
+	Ciphers                types.String                        `tfsdk:"ciphers"`
+	Ciphersuites           types.String                        `tfsdk:"ciphersuites"`
+	Verify                 types.String                        `tfsdk:"verify"`
+	AcceptProxy            types.Bool                          `tfsdk:"accept_proxy"`
+	DeferAccept            types.Bool                          `tfsdk:"defer_accept"`
+	TcpUserTimeout         types.Int64                         `tfsdk:"tcp_user_timeout"`
+	Tfo                    types.Bool                          `tfsdk:"tfo"`
+	V4v6                   types.Bool                          `tfsdk:"v4v6"`
+	V6only                 types.Bool                          `tfsdk:"v6only"`
+	MonitorUri             types.String                        `tfsdk:"monitor_uri"`
+	Defaults               types.String                        `tfsdk:"defaults"`
+	Binds                  map[string]haproxyBindModel         `tfsdk:"binds"`
+	Acls                   []haproxyAclModel                   `tfsdk:"acls"`
+	HttpRequestRules       []haproxyHttpRequestRuleModel       `tfsdk:"http_request_rules"`
+	HttpResponseRules      []haproxyHttpResponseRuleModel      `tfsdk:"http_response_rules"`
+	HttpAfterResponseRules []haproxyHttpAfterResponseRuleModel `tfsdk:"http_after_response_rules"`
+	TcpRequestRules        []haproxyTcpRequestRuleModel        `tfsdk:"tcp_request_rules"`
+	BackendSwitchingRules  []haproxyBackendSwitchingRuleModel  `tfsdk:"backend_switching_rules"`
+	StatsOptions           []haproxyStatsOptionsModel          `tfsdk:"stats_options"`
+	MonitorFail            []haproxyMonitorFailModel           `tfsdk:"monitor_fail"`
+	Compression            *haproxyCompressionModel            `tfsdk:"compression"`
+	Filters                []haproxyFilterModel                `tfsdk:"filters"`
+	ErrorFiles             []haproxyErrorFilesModel            `tfsdk:"error_files"`
 }
 
 // haproxyBalanceModel maps the balance block schema data.
@@ -394,6 +465,84 @@ type haproxyTcpRequestRuleModel struct {
 	WaitTime            types.Int64  `tfsdk:"wait_time"`
 }
 
+// haproxyStickRuleModel maps the stick_rules block schema data.
+type haproxyStickRuleModel struct {
+	Type     types.String `tfsdk:"type"`
+	Pattern  types.String `tfsdk:"pattern"`
+	Table    types.String `tfsdk:"table"`
+	Cond     types.String `tfsdk:"cond"`
+	CondTest types.String `tfsdk:"cond_test"`
+}
+
+// haproxyBackendSwitchingRuleModel maps the backend_switching_rules block schema data.
+type haproxyBackendSwitchingRuleModel struct {
+	Name     types.String `tfsdk:"name"`
+	Cond     types.String `tfsdk:"cond"`
+	CondTest types.String `tfsdk:"cond_test"`
+}
+
+// haproxyServerSwitchingRuleModel maps the server_switching_rules block schema data.
+type haproxyServerSwitchingRuleModel struct {
+	TargetServer types.String `tfsdk:"target_server"`
+	Cond         types.String `tfsdk:"cond"`
+	CondTest     types.String `tfsdk:"cond_test"`
+}
+
+// haproxyHttpAfterResponseRuleModel maps the http_after_response_rules block schema data.
+type haproxyHttpAfterResponseRuleModel struct {
+	Type         types.String `tfsdk:"type"`
+	Cond         types.String `tfsdk:"cond"`
+	CondTest     types.String `tfsdk:"cond_test"`
+	HdrName      types.String `tfsdk:"hdr_name"`
+	HdrFormat    types.String `tfsdk:"hdr_format"`
+	HdrMatch     types.String `tfsdk:"hdr_match"`
+	Status       types.Int64  `tfsdk:"status"`
+	StatusReason types.String `tfsdk:"status_reason"`
+	VarName      types.String `tfsdk:"var_name"`
+	VarScope     types.String `tfsdk:"var_scope"`
+	VarExpr      types.String `tfsdk:"var_expr"`
+	MapFile      types.String `tfsdk:"map_file"`
+	MapKeyfmt    types.String `tfsdk:"map_keyfmt"`
+	MapValuefmt  types.String `tfsdk:"map_valuefmt"`
+	LogLevel     types.String `tfsdk:"log_level"`
+	StrictMode   types.String `tfsdk:"strict_mode"`
+}
+
+// haproxyCompressionModel maps the compression block schema data.
+type haproxyCompressionModel struct {
+	Algorithms []types.String `tfsdk:"algorithms"`
+	Types      []types.String `tfsdk:"types"`
+	Offload    types.Bool     `tfsdk:"offload"`
+}
+
+// haproxyFilterModel maps the filters block schema data.
+type haproxyFilterModel struct {
+	Type               types.String `tfsdk:"type"`
+	AppName            types.String `tfsdk:"app_name"`
+	BandwidthLimitName types.String `tfsdk:"bandwidth_limit_name"`
+	CacheName          types.String `tfsdk:"cache_name"`
+	DefaultLimit       types.Int64  `tfsdk:"default_limit"`
+	DefaultPeriod      types.Int64  `tfsdk:"default_period"`
+	Key                types.String `tfsdk:"key"`
+	Limit              types.Int64  `tfsdk:"limit"`
+	MinSize            types.Int64  `tfsdk:"min_size"`
+	SpoeConfig         types.String `tfsdk:"spoe_config"`
+	SpoeEngine         types.String `tfsdk:"spoe_engine"`
+	Table              types.String `tfsdk:"table"`
+	TraceName          types.String `tfsdk:"trace_name"`
+	TraceHexdump       types.Bool   `tfsdk:"trace_hexdump"`
+	TraceRndForwarding types.Bool   `tfsdk:"trace_rnd_forwarding"`
+	TraceRndParsing    types.Bool   `tfsdk:"trace_rnd_parsing"`
+}
+
+// haproxyErrorFilesModel maps the error_files block schema data.
+type haproxyErrorFilesModel struct {
+	Code       types.Int64  `tfsdk:"code"`
+	Content    types.String `tfsdk:"content"`
+	HttpErrors types.String `tfsdk:"http_errors"`
+	File       types.String `tfsdk:"file"`
+}
+
 // haproxyTcpResponseRuleModel maps the tcp_response_rule block schema data.
 type haproxyTcpResponseRuleModel struct {
 	Type                 types.String `tfsdk:"type"`
@@ -469,6 +618,20 @@ type haproxyStickTableModel struct {
 	Peers   types.String `tfsdk:"peers"`
 }
 
+// haproxyEmailAlertModel maps the email_alert block schema data.
+type haproxyEmailAlertModel struct {
+	From       types.String `tfsdk:"from"`
+	To         types.String `tfsdk:"to"`
+	Level      types.String `tfsdk:"level"`
+	Mailers    types.String `tfsdk:"mailers"`
+	Myhostname types.String `tfsdk:"myhostname"`
+}
+
+// haproxyDrainBeforeDeleteModel maps the drain_before_delete block schema data.
+type haproxyDrainBeforeDeleteModel struct {
+	Timeout types.Int64 `tfsdk:"timeout"`
+}
+
 // haproxyStatsOptionsModel maps the stats_options block schema data.
 type haproxyStatsOptionsModel struct {
 	StatsEnable types.Bool   `tfsdk:"stats_enable"`
@@ -500,8 +663,9 @@ func (r *haproxyStackResource) Schema(_ context.Context, _ resource.SchemaReques
 			},
 		},
 		Blocks: map[string]schema.Block{
-			"backend":  GetBackendSchema(),
-			"frontend": GetFrontendSchema(),
+			"backend":          GetBackendSchema(),
+			"frontend":         GetFrontendSchema(),
+			"wait_for_healthy": GetWaitForHealthySchema(),
 		},
 		MarkdownDescription: "Manages a complete HAProxy stack including backend, server, frontend, and ACLs.\n\n## Example Usage\n\n```hcl\nresource \"haproxy_stack\" \"web_app\" {\n  name = \"web_application\"\n  \n  backend {\n    name = \"web_backend\"\n    mode = \"http\"\n    \n    # Backend ACLs\n    acls {\n      acl_name = \"is_api\"\n      criterion = \"path\"\n      value     = \"/api\"\n    }\n    \n    # HTTP request rules\n    http_request_rules {\n      type      = \"allow\"\n      cond      = \"if\"\n      cond_test = \"is_api\"\n    }\n    \n    # Health checks\n    http_checks {\n      type = \"connect\"\n      addr = \"127.0.0.1\"\n      port = 80\n    }\n    \n    # Servers (nested under backend)\n    servers = {\n      \"web_server_1\" = {\n        address = \"192.168.1.10\"\n        port    = 8080\n        check   = \"enabled\"\n        weight  = 100\n      }\n      \n      \"web_server_2\" = {\n        address = \"192.168.1.11\"\n        port    = 8080\n        check   = \"enabled\"\n        weight  = 100\n      }\n    }\n  }\n  \n  frontend {\n    name           = \"web_frontend\"\n    mode           = \"http\"\n    default_backend = \"web_backend\"\n    \n    # Frontend ACLs\n    acls {\n      acl_name = \"is_admin\"\n      criterion = \"path\"\n      value     = \"/admin\"\n    }\n    \n    # Bind configuration\n    binds = {\n      http_bind = {\n        address = \"0.0.0.0\"\n        port    = 80\n      }\n    }\n  }\n}\n```",
 	}
@@ -570,6 +734,33 @@ func (r *haproxyStackResource) Delete(ctx context.Context, req resource.DeleteRe
 	}
 }
 
+// ImportState imports an existing frontend/backend pair into a haproxy_stack resource.
+// The import ID has the format <frontend>/<backend>.
+func (r *haproxyStackResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if err := r.stackManager.ImportState(ctx, req, resp); err != nil {
+		resp.Diagnostics.AddError("Error importing HAProxy stack", err.Error())
+	}
+}
+
+// ValidateConfig runs the checks that do not depend on the API version at plan time.
+// Configurations whose blocks cannot be decoded yet (e.g. servers built from unknown values)
+// are checked again before create and update.
+func (r *haproxyStackResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config haproxyStackResourceModel
+	if diags := req.Config.Get(ctx, &config); diags.HasError() {
+		return
+	}
+
+	validateStackConfig(&resp.Diagnostics, &config)
+}
+
+// validateStackConfig runs the validations of the stack that do not differ between API versions.
+func validateStackConfig(diags *diag.Diagnostics, config *haproxyStackResourceModel) {
+	validateStackRuleActions(diags, config)
+	validateStackErrorFiles(diags, config)
+	validateStackWaitForHealthy(diags, config)
+}
+
 // validateConfigForAPIVersion validates the configuration based on API version
 func (r *haproxyStackResource) validateConfigForAPIVersion(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) error {
 	// Get the API version from the provider configuration
@@ -624,6 +815,8 @@ func (r *haproxyStackResource) validateConfigForAPIVersion(ctx context.Context,
 		}
 	}
 
+	validateStackConfig(&resp.Diagnostics, &config)
+
 	// Check if validation produced any errors
 	if resp.Diagnostics.HasError() {
 		return fmt.Errorf("configuration validation failed")
@@ -686,6 +879,8 @@ func (r *haproxyStackResource) validateConfigForAPIVersionUpdate(ctx context.Con
 		}
 	}
 
+	validateStackConfig(&resp.Diagnostics, &config)
+
 	// Check if validation produced any errors
 	if resp.Diagnostics.HasError() {
 		return fmt.Errorf("configuration validation failed")
@@ -694,6 +889,58 @@ func (r *haproxyStackResource) validateConfigForAPIVersionUpdate(ctx context.Con
 	return nil
 }
 
+// validateStackRuleActions validates the cache-use, cache-store and set-var rules, and the
+// arguments of the switching and http-after-response rules and of the filters of the
+// frontend and backend; they do not differ between API versions.
+func validateStackRuleActions(diags *diag.Diagnostics, config *haproxyStackResourceModel) {
+	if config.Frontend != nil {
+		for i, rule := range config.Frontend.HttpRequestRules {
+			rule.validateCacheAction(diags, path.Root("frontend").AtName("http_request_rules").AtListIndex(i))
+			rule.validateSetVarAction(diags, path.Root("frontend").AtName("http_request_rules").AtListIndex(i))
+		}
+		for i, rule := range config.Frontend.HttpResponseRules {
+			rule.validateCacheAction(diags, path.Root("frontend").AtName("http_response_rules").AtListIndex(i))
+		}
+		for i, rule := range config.Frontend.HttpAfterResponseRules {
+			rule.validate(diags, path.Root("frontend").AtName("http_after_response_rules").AtListIndex(i))
+		}
+		for i, rule := range config.Frontend.BackendSwitchingRules {
+			rule.validate(diags, path.Root("frontend").AtName("backend_switching_rules").AtListIndex(i))
+		}
+		validateFilters(diags, config.Frontend.Filters, config.Frontend.Compression, path.Root("frontend"))
+	}
+	if config.Backend != nil {
+		for i, rule := range config.Backend.HttpRequestRules {
+			rule.validateCacheAction(diags, path.Root("backend").AtName("http_request_rules").AtListIndex(i))
+			rule.validateSetVarAction(diags, path.Root("backend").AtName("http_request_rules").AtListIndex(i))
+		}
+		for i, rule := range config.Backend.HttpResponseRules {
+			rule.validateCacheAction(diags, path.Root("backend").AtName("http_response_rules").AtListIndex(i))
+		}
+		for i, rule := range config.Backend.HttpAfterResponseRules {
+			rule.validate(diags, path.Root("backend").AtName("http_after_response_rules").AtListIndex(i))
+		}
+		for i, rule := range config.Backend.ServerSwitchingRules {
+			rule.validate(diags, path.Root("backend").AtName("server_switching_rules").AtListIndex(i), config.Backend.Servers)
+		}
+		validateFilters(diags, config.Backend.Filters, config.Backend.Compression, path.Root("backend"))
+	}
+}
+
+// validateStackErrorFiles validates the error_files blocks of the frontend and backend.
+func validateStackErrorFiles(diags *diag.Diagnostics, config *haproxyStackResourceModel) {
+	if config.Frontend != nil {
+		for i, errorFile := range config.Frontend.ErrorFiles {
+			errorFile.validate(diags, path.Root("frontend").AtName("error_files").AtListIndex(i))
+		}
+	}
+	if config.Backend != nil {
+		for i, errorFile := range config.Backend.ErrorFiles {
+			errorFile.validate(diags, path.Root("backend").AtName("error_files").AtListIndex(i))
+		}
+	}
+}
+
 // Create-specific validation functions that work with diag.Diagnostics
 func validateDefaultServerV2ForCreate(ctx context.Context, diags *diag.Diagnostics, defaultServer *haproxyDefaultServerModel, pathPrefix string) {
 	if !defaultServer.Sslv3.IsNull() {
@@ -735,6 +982,24 @@ func validateDefaultServerV2ForCreate(ctx context.Context, diags *diag.Diagnosti
 
 // validateServerV2ForCreate validates that v3 fields are not used in v2 mode
 func validateServerV2ForCreate(ctx context.Context, diags *diag.Diagnostics, server *haproxyServerModel, pathPrefix string) {
+	validateServerOptions(diags, server, pathPrefix)
+	// v2 restricts protocol versions with no_* and force_*, which can leave a cipher list unused
+	forcesBelowTlsv13 := server.ForceSslv3.ValueString() == "enabled" || server.ForceTlsv10.ValueString() == "enabled" ||
+		server.ForceTlsv11.ValueString() == "enabled" || server.ForceTlsv12.ValueString() == "enabled"
+	if !server.Ciphersuites.IsNull() && (server.NoTlsv13.ValueString() == "enabled" || forcesBelowTlsv13) {
+		diags.AddAttributeWarning(
+			path.Root(pathPrefix).AtName("ciphersuites"),
+			"Unused ciphersuites",
+			"Field 'ciphersuites' only applies to TLSv1.3, which 'no_tlsv13' or a 'force_*' field disables for this server.",
+		)
+	}
+	if !server.Ciphers.IsNull() && server.ForceTlsv13.ValueString() == "enabled" {
+		diags.AddAttributeWarning(
+			path.Root(pathPrefix).AtName("ciphers"),
+			"Unused ciphers",
+			"Field 'ciphers' only applies to TLSv1.2 and lower, which 'force_tlsv13' disables for this server. Use 'ciphersuites' instead.",
+		)
+	}
 	if !server.Sslv3.IsNull() {
 		diags.AddAttributeError(
 			path.Root(pathPrefix).AtName("sslv3"),
@@ -887,6 +1152,23 @@ func validateDefaultServerV3ForCreate(ctx context.Context, diags *diag.Diagnosti
 
 // validateServerV3ForCreate validates that deprecated v2 fields are not used in v3 mode
 func validateServerV3ForCreate(ctx context.Context, diags *diag.Diagnostics, server *haproxyServerModel, pathPrefix string) {
+	validateServerOptions(diags, server, pathPrefix)
+	// v3 restricts protocol versions with sslv3 and tlsv*, which can leave a cipher list unused
+	if !server.Ciphersuites.IsNull() && server.Tlsv13.ValueString() == "disabled" {
+		diags.AddAttributeWarning(
+			path.Root(pathPrefix).AtName("ciphersuites"),
+			"Unused ciphersuites",
+			"Field 'ciphersuites' only applies to TLSv1.3, which 'tlsv13' disables for this server.",
+		)
+	}
+	if !server.Ciphers.IsNull() && server.Tlsv10.ValueString() == "disabled" && server.Tlsv11.ValueString() == "disabled" &&
+		server.Tlsv12.ValueString() == "disabled" && server.Sslv3.ValueString() != "enabled" {
+		diags.AddAttributeWarning(
+			path.Root(pathPrefix).AtName("ciphers"),
+			"Unused ciphers",
+			"Field 'ciphers' only applies to TLSv1.2 and lower, which 'tlsv10', 'tlsv11' and 'tlsv12' disable for this server. Use 'ciphersuites' instead.",
+		)
+	}
 	if !server.NoSslv3.IsNull() {
 		diags.AddAttributeError(
 			path.Root(pathPrefix).AtName("no_sslv3"),
@@ -959,6 +1241,34 @@ func validateServerV3ForCreate(ctx context.Context, diags *diag.Diagnostics, ser
 	}
 }
 
+// validateServerOptions validates the server options that are accepted by both API versions
+// but rejected by HAProxy when they are combined inconsistently.
+func validateServerOptions(diags *diag.Diagnostics, server *haproxyServerModel, pathPrefix string) {
+	if server.AgentCheck.ValueString() == "enabled" && server.AgentPort.IsNull() {
+		diags.AddAttributeError(
+			path.Root(pathPrefix).AtName("agent_port"),
+			"Missing agent_port",
+			"Field 'agent_check' is enabled but 'agent_port' is not set. HAProxy requires the port of the agent.",
+		)
+	}
+	sendsProxyV2 := server.SendProxyV2.ValueString() == "enabled" || server.SendProxyV2Ssl.ValueString() == "enabled" ||
+		server.SendProxyV2SslCn.ValueString() == "enabled"
+	if len(server.ProxyV2Options) > 0 && !sendsProxyV2 {
+		diags.AddAttributeError(
+			path.Root(pathPrefix).AtName("proxy_v2_options"),
+			"Invalid proxy_v2_options",
+			"Field 'proxy_v2_options' only applies to the PROXY protocol v2 header. Enable 'send_proxy_v2', 'send_proxy_v2_ssl' or 'send_proxy_v2_ssl_cn'.",
+		)
+	}
+	if server.SendProxy.ValueString() == "enabled" && sendsProxyV2 {
+		diags.AddAttributeError(
+			path.Root(pathPrefix).AtName("send_proxy"),
+			"Conflicting PROXY protocol versions",
+			"Field 'send_proxy' sends a PROXY protocol v1 header and cannot be combined with a v2 header.",
+		)
+	}
+}
+
 // validateBindV3ForCreate validates that deprecated v2 fields are not used in v3 mode
 func validateBindV3ForCreate(ctx context.Context, diags *diag.Diagnostics, bind haproxyBindModel, pathPrefix string) {
 	if !bind.NoSslv3.IsNull() {
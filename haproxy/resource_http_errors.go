@@ -0,0 +1,266 @@
+package haproxy
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &httpErrorsResource{}
+	_ resource.ResourceWithConfigure   = &httpErrorsResource{}
+	_ resource.ResourceWithImportState = &httpErrorsResource{}
+)
+
+// NewHttpErrorsResource is a helper function to simplify the provider implementation.
+func NewHttpErrorsResource() resource.Resource {
+	return &httpErrorsResource{}
+}
+
+// httpErrorsResource manages an http-errors section and the error pages it references.
+type httpErrorsResource struct {
+	client *HAProxyClient
+}
+
+// httpErrorsResourceModel maps the resource schema data.
+type httpErrorsResourceModel struct {
+	ID         types.String                 `tfsdk:"id"`
+	Name       types.String                 `tfsdk:"name"`
+	ErrorFiles []haproxyHttpErrorsFileModel `tfsdk:"errorfile"`
+}
+
+// haproxyHttpErrorsFileModel maps the errorfile block schema data.
+type haproxyHttpErrorsFileModel struct {
+	Code    types.Int64  `tfsdk:"code"`
+	Content types.String `tfsdk:"content"`
+	File    types.String `tfsdk:"file"`
+}
+
+// Metadata returns the resource type name.
+func (r *httpErrorsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_http_errors"
+}
+
+// Schema defines the schema for the resource.
+func (r *httpErrorsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages an HAProxy http-errors section. Error pages are stored through the Data Plane API storage and can be used by frontends and backends through error_files blocks.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The name of the http-errors section.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the http-errors section, referenced by the http_errors attribute of error_files blocks.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"errorfile": schema.ListNestedBlock{
+				Description: "An error page of the section.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"code": schema.Int64Attribute{
+							Required:    true,
+							Description: "The HTTP status code the page is returned for (e.g. 503).",
+							Validators: []validator.Int64{
+								int64validator.OneOf(errorPageCodes...),
+							},
+						},
+						"content": schema.StringAttribute{
+							Required:    true,
+							Description: "The raw HTTP response, headers included, stored as a file through the Data Plane API storage.",
+						},
+						"file": schema.StringAttribute{
+							Computed:    true,
+							Description: "The path of the stored page on the HAProxy host.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *httpErrorsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+// Create stores the error pages, then creates the http-errors section referencing them.
+func (r *httpErrorsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan httpErrorsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := plan.Name.ValueString()
+	errorFiles := toErrorFilesModels(plan.ErrorFiles)
+	if err := CreateErrorFilesManager(r.client).Store(ctx, "http_errors", name, errorFiles, nil); err != nil {
+		resp.Diagnostics.AddError("Error creating http-errors", fmt.Sprintf("Could not create http-errors %s: %s", name, err))
+		return
+	}
+
+	err := r.client.InTransaction(func(transactionID string) error {
+		return r.client.CreateHttpErrorsInTransaction(ctx, transactionID, r.toPayload(name, errorFiles))
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating http-errors", fmt.Sprintf("Could not create http-errors %s: %s", name, err))
+		return
+	}
+
+	plan.ID = types.StringValue(name)
+	plan.ErrorFiles = fromErrorFilesModels(errorFiles)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *httpErrorsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state httpErrorsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := state.Name.ValueString()
+	section, err := r.client.ReadHttpErrors(ctx, name)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading http-errors", fmt.Sprintf("Could not read http-errors %s: %s", name, err))
+		return
+	}
+	if section == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.ID = types.StringValue(name)
+	errorFiles := CreateErrorFilesManager(r.client).Read(ctx, section.ErrorFiles, nil, toErrorFilesModels(state.ErrorFiles))
+	state.ErrorFiles = fromErrorFilesModels(errorFiles)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Update stores changed error pages, updates the section and deletes the pages it no longer uses.
+func (r *httpErrorsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state httpErrorsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := plan.Name.ValueString()
+	errorFilesManager := CreateErrorFilesManager(r.client)
+	previous := toErrorFilesModels(state.ErrorFiles)
+	errorFiles := toErrorFilesModels(plan.ErrorFiles)
+	if err := errorFilesManager.Store(ctx, "http_errors", name, errorFiles, previous); err != nil {
+		resp.Diagnostics.AddError("Error updating http-errors", fmt.Sprintf("Could not update http-errors %s: %s", name, err))
+		return
+	}
+
+	err := r.client.InTransaction(func(transactionID string) error {
+		return r.client.UpdateHttpErrorsInTransaction(ctx, transactionID, r.toPayload(name, errorFiles))
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating http-errors", fmt.Sprintf("Could not update http-errors %s: %s", name, err))
+		return
+	}
+	errorFilesManager.Cleanup(ctx, previous, errorFiles)
+
+	plan.ID = types.StringValue(name)
+	plan.ErrorFiles = fromErrorFilesModels(errorFiles)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Delete deletes the http-errors section and its stored error pages.
+func (r *httpErrorsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state httpErrorsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := state.Name.ValueString()
+	err := r.client.InTransaction(func(transactionID string) error {
+		return r.client.DeleteHttpErrorsInTransaction(ctx, transactionID, name)
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error deleting http-errors", fmt.Sprintf("Could not delete http-errors %s: %s", name, err))
+		return
+	}
+	CreateErrorFilesManager(r.client).Cleanup(ctx, toErrorFilesModels(state.ErrorFiles), nil)
+}
+
+// ImportState imports an http-errors section by name.
+func (r *httpErrorsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), req.ID)...)
+}
+
+// toPayload builds the section payload from stored error pages.
+func (r *httpErrorsResource) toPayload(name string, errorFiles []haproxyErrorFilesModel) *HttpErrorsPayload {
+	payload := &HttpErrorsPayload{Name: name, ErrorFiles: []ErrorFilePayload{}}
+	for _, errorFile := range errorFiles {
+		payload.ErrorFiles = append(payload.ErrorFiles, ErrorFilePayload{
+			Code: errorFile.Code.ValueInt64(),
+			File: errorFile.File.ValueString(),
+		})
+	}
+	return payload
+}
+
+// toErrorFilesModels converts errorfile blocks to error_files blocks so that they can be
+// handled by the ErrorFilesManager.
+func toErrorFilesModels(errorFiles []haproxyHttpErrorsFileModel) []haproxyErrorFilesModel {
+	var models []haproxyErrorFilesModel
+	for _, errorFile := range errorFiles {
+		models = append(models, haproxyErrorFilesModel{
+			Code:       errorFile.Code,
+			Content:    errorFile.Content,
+			HttpErrors: types.StringNull(),
+			File:       errorFile.File,
+		})
+	}
+	return models
+}
+
+// fromErrorFilesModels converts error_files blocks back to errorfile blocks.
+func fromErrorFilesModels(errorFiles []haproxyErrorFilesModel) []haproxyHttpErrorsFileModel {
+	var models []haproxyHttpErrorsFileModel
+	for _, errorFile := range errorFiles {
+		models = append(models, haproxyHttpErrorsFileModel{
+			Code:    errorFile.Code,
+			Content: errorFile.Content,
+			File:    errorFile.File,
+		})
+	}
+	return models
+}
@@ -0,0 +1,393 @@
+package haproxy
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &logForwardResource{}
+	_ resource.ResourceWithConfigure   = &logForwardResource{}
+	_ resource.ResourceWithImportState = &logForwardResource{}
+)
+
+// NewLogForwardResource is a helper function to simplify the provider implementation.
+func NewLogForwardResource() resource.Resource {
+	return &logForwardResource{}
+}
+
+// logForwardResource manages a log-forward section with its listeners and log targets.
+type logForwardResource struct {
+	client *HAProxyClient
+}
+
+// logForwardResourceModel maps the resource schema data.
+type logForwardResourceModel struct {
+	ID            types.String                 `tfsdk:"id"`
+	Name          types.String                 `tfsdk:"name"`
+	Backlog       types.Int64                  `tfsdk:"backlog"`
+	Maxconn       types.Int64                  `tfsdk:"maxconn"`
+	TimeoutClient types.Int64                  `tfsdk:"timeout_client"`
+	DgramBinds    []haproxyLogForwardBindModel `tfsdk:"dgram_bind"`
+	Binds         []haproxyLogForwardBindModel `tfsdk:"bind"`
+	LogTargets    []haproxyLogTargetModel      `tfsdk:"log_target"`
+}
+
+// haproxyLogForwardBindModel maps the dgram_bind and bind block schema data.
+type haproxyLogForwardBindModel struct {
+	Name    types.String `tfsdk:"name"`
+	Address types.String `tfsdk:"address"`
+	Port    types.Int64  `tfsdk:"port"`
+}
+
+// Metadata returns the resource type name.
+func (r *logForwardResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_log_forward"
+}
+
+// Schema defines the schema for the resource.
+func (r *logForwardResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages an HAProxy log-forward section, which receives syslog messages over UDP or TCP and relays them to log targets.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The name of the log-forward section.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the log-forward section.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"backlog": schema.Int64Attribute{
+				Optional:    true,
+				Description: "The backlog of pending TCP connections.",
+			},
+			"maxconn": schema.Int64Attribute{
+				Optional:    true,
+				Description: "The maximum number of concurrent TCP connections.",
+			},
+			"timeout_client": schema.Int64Attribute{
+				Optional:    true,
+				Description: "The inactivity timeout of TCP connections, in milliseconds.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"dgram_bind": getLogForwardBindSchema("A UDP address on which syslog messages are received (dgram-bind)."),
+			"bind":       getLogForwardBindSchema("A TCP address on which syslog messages are received (bind)."),
+			"log_target": GetLogTargetSchema(),
+		},
+	}
+}
+
+// getLogForwardBindSchema returns the schema shared by the dgram_bind and bind blocks.
+func getLogForwardBindSchema(description string) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		Description: description,
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"name": schema.StringAttribute{
+					Required:    true,
+					Description: "The name of the listener.",
+				},
+				"address": schema.StringAttribute{
+					Required:    true,
+					Description: "The address to listen on.",
+				},
+				"port": schema.Int64Attribute{
+					Optional:    true,
+					Description: "The port to listen on.",
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *logForwardResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+// Create creates the log-forward section with its listeners and log targets in a single transaction.
+func (r *logForwardResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan logForwardResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := plan.Name.ValueString()
+	err := r.client.InTransaction(func(transactionID string) error {
+		if err := r.client.CreateLogForwardInTransaction(ctx, transactionID, r.toPayload(&plan)); err != nil {
+			return err
+		}
+		if err := r.reconcileDgramBinds(ctx, transactionID, name, nil, plan.DgramBinds); err != nil {
+			return err
+		}
+		if err := r.reconcileBinds(ctx, transactionID, name, nil, plan.Binds); err != nil {
+			return err
+		}
+		if len(plan.LogTargets) == 0 {
+			return nil
+		}
+		return r.client.CreateAllLogTargetsInTransaction(ctx, transactionID, "log_forward", name, logTargetsToPayloads(plan.LogTargets))
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating log forward", fmt.Sprintf("Could not create log forward %s: %s", name, err))
+		return
+	}
+
+	plan.ID = types.StringValue(name)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *logForwardResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state logForwardResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := state.Name.ValueString()
+	logForward, err := r.client.ReadLogForward(ctx, name)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading log forward", fmt.Sprintf("Could not read log forward %s: %s", name, err))
+		return
+	}
+	if logForward == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	dgramBinds, err := r.client.ReadDgramBinds(ctx, name)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading dgram binds", fmt.Sprintf("Could not read dgram binds of %s: %s", name, err))
+		return
+	}
+	binds, err := r.client.ReadBinds(ctx, "log_forward", name)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading binds", fmt.Sprintf("Could not read binds of %s: %s", name, err))
+		return
+	}
+	logTargets, err := r.client.ReadLogTargets(ctx, "log_forward", name)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading log targets", fmt.Sprintf("Could not read log targets of %s: %s", name, err))
+		return
+	}
+
+	var readDgramBinds, readBinds []haproxyLogForwardBindModel
+	for _, dgramBind := range dgramBinds {
+		readDgramBinds = append(readDgramBinds, logForwardBindModel(dgramBind.Name, dgramBind.Address, dgramBind.Port))
+	}
+	for _, bind := range binds {
+		readBinds = append(readBinds, logForwardBindModel(bind.Name, bind.Address, bind.Port))
+	}
+
+	state.ID = types.StringValue(name)
+	state.Backlog = int64OrNull(logForward.Backlog)
+	state.Maxconn = int64OrNull(logForward.Maxconn)
+	state.TimeoutClient = int64OrNull(logForward.TimeoutClient)
+	state.DgramBinds = mergeLogForwardBinds(state.DgramBinds, readDgramBinds)
+	state.Binds = mergeLogForwardBinds(state.Binds, readBinds)
+	state.LogTargets = logTargetsFromPayloads(logTargets)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Update updates the log-forward section, reconciles listeners by name and replaces the
+// log targets in a single transaction.
+func (r *logForwardResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state logForwardResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := plan.Name.ValueString()
+	err := r.client.InTransaction(func(transactionID string) error {
+		if err := r.client.UpdateLogForwardInTransaction(ctx, transactionID, name, r.toPayload(&plan)); err != nil {
+			return err
+		}
+		if err := r.reconcileDgramBinds(ctx, transactionID, name, state.DgramBinds, plan.DgramBinds); err != nil {
+			return err
+		}
+		if err := r.reconcileBinds(ctx, transactionID, name, state.Binds, plan.Binds); err != nil {
+			return err
+		}
+		if len(plan.LogTargets) == 0 && len(state.LogTargets) == 0 {
+			return nil
+		}
+		return r.client.ReplaceLogTargetsInTransaction(ctx, transactionID, "log_forward", name, logTargetsToPayloads(plan.LogTargets))
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating log forward", fmt.Sprintf("Could not update log forward %s: %s", name, err))
+		return
+	}
+
+	plan.ID = types.StringValue(name)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Delete deletes the log-forward section; its listeners and log targets are removed with it.
+func (r *logForwardResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state logForwardResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := state.Name.ValueString()
+	err := r.client.InTransaction(func(transactionID string) error {
+		return r.client.DeleteLogForwardInTransaction(ctx, transactionID, name)
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error deleting log forward", fmt.Sprintf("Could not delete log forward %s: %s", name, err))
+	}
+}
+
+// ImportState imports a log-forward section by name.
+func (r *logForwardResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), req.ID)...)
+}
+
+// reconcileDgramBinds creates, updates and deletes dgram-binds by name.
+func (r *logForwardResource) reconcileDgramBinds(ctx context.Context, transactionID, logForward string, current, desired []haproxyLogForwardBindModel) error {
+	existing, wanted := indexLogForwardBinds(current), indexLogForwardBinds(desired)
+	for name := range existing {
+		if _, ok := wanted[name]; !ok {
+			if err := r.client.DeleteDgramBindInTransaction(ctx, transactionID, logForward, name); err != nil {
+				return fmt.Errorf("dgram bind %s: %w", name, err)
+			}
+		}
+	}
+	for _, dgramBind := range desired {
+		payload := &DgramBindPayload{
+			Name:    dgramBind.Name.ValueString(),
+			Address: dgramBind.Address.ValueString(),
+			Port:    dgramBind.Port.ValueInt64Pointer(),
+		}
+		currentBind, exists := existing[payload.Name]
+		switch {
+		case !exists:
+			if err := r.client.CreateDgramBindInTransaction(ctx, transactionID, logForward, payload); err != nil {
+				return fmt.Errorf("dgram bind %s: %w", payload.Name, err)
+			}
+		case !currentBind.Address.Equal(dgramBind.Address) || !currentBind.Port.Equal(dgramBind.Port):
+			if err := r.client.UpdateDgramBindInTransaction(ctx, transactionID, logForward, payload); err != nil {
+				return fmt.Errorf("dgram bind %s: %w", payload.Name, err)
+			}
+		}
+	}
+	return nil
+}
+
+// reconcileBinds creates, updates and deletes TCP binds by name.
+func (r *logForwardResource) reconcileBinds(ctx context.Context, transactionID, logForward string, current, desired []haproxyLogForwardBindModel) error {
+	existing, wanted := indexLogForwardBinds(current), indexLogForwardBinds(desired)
+	for name := range existing {
+		if _, ok := wanted[name]; !ok {
+			if err := checkBindResponse(r.client.DeleteBindInTransaction(ctx, transactionID, name, "log_forward", logForward)); err != nil {
+				return fmt.Errorf("bind %s: %w", name, err)
+			}
+		}
+	}
+	for _, bind := range desired {
+		payload := &BindPayload{
+			Name:    bind.Name.ValueString(),
+			Address: bind.Address.ValueString(),
+			Port:    bind.Port.ValueInt64Pointer(),
+		}
+		currentBind, exists := existing[payload.Name]
+		switch {
+		case !exists:
+			if err := checkBindResponse(r.client.CreateBindInTransaction(ctx, transactionID, "log_forward", logForward, payload)); err != nil {
+				return fmt.Errorf("bind %s: %w", payload.Name, err)
+			}
+		case !currentBind.Address.Equal(bind.Address) || !currentBind.Port.Equal(bind.Port):
+			if err := checkBindResponse(r.client.UpdateBindInTransaction(ctx, transactionID, payload.Name, "log_forward", logForward, payload)); err != nil {
+				return fmt.Errorf("bind %s: %w", payload.Name, err)
+			}
+		}
+	}
+	return nil
+}
+
+// toPayload converts the resource model to a LogForwardPayload.
+func (r *logForwardResource) toPayload(model *logForwardResourceModel) *LogForwardPayload {
+	return &LogForwardPayload{
+		Name:          model.Name.ValueString(),
+		Backlog:       model.Backlog.ValueInt64(),
+		Maxconn:       model.Maxconn.ValueInt64(),
+		TimeoutClient: model.TimeoutClient.ValueInt64(),
+	}
+}
+
+// logForwardBindModel builds a dgram_bind or bind block from the fields read from HAProxy.
+func logForwardBindModel(name, address string, port *int64) haproxyLogForwardBindModel {
+	model := haproxyLogForwardBindModel{
+		Name:    types.StringValue(name),
+		Address: types.StringValue(address),
+		Port:    types.Int64Null(),
+	}
+	if port != nil {
+		model.Port = types.Int64Value(*port)
+	}
+	return model
+}
+
+// indexLogForwardBinds returns the listeners keyed by name.
+func indexLogForwardBinds(binds []haproxyLogForwardBindModel) map[string]haproxyLogForwardBindModel {
+	byName := make(map[string]haproxyLogForwardBindModel, len(binds))
+	for _, bind := range binds {
+		byName[bind.Name.ValueString()] = bind
+	}
+	return byName
+}
+
+// mergeLogForwardBinds returns the listeners read from HAProxy in the order of the current state.
+func mergeLogForwardBinds(current, read []haproxyLogForwardBindModel) []haproxyLogForwardBindModel {
+	byName := indexLogForwardBinds(read)
+
+	var result []haproxyLogForwardBindModel
+	seen := make(map[string]bool, len(read))
+	for _, bind := range current {
+		if readBind, ok := byName[bind.Name.ValueString()]; ok {
+			seen[bind.Name.ValueString()] = true
+			result = append(result, readBind)
+		}
+	}
+	for _, bind := range read {
+		if !seen[bind.Name.ValueString()] {
+			result = append(result, bind)
+		}
+	}
+	return result
+}
@@ -0,0 +1,301 @@
+package haproxy
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &mailersResource{}
+	_ resource.ResourceWithConfigure   = &mailersResource{}
+	_ resource.ResourceWithImportState = &mailersResource{}
+)
+
+// NewMailersResource is a helper function to simplify the provider implementation.
+func NewMailersResource() resource.Resource {
+	return &mailersResource{}
+}
+
+// mailersResource manages a mailers section and its mailer entries.
+type mailersResource struct {
+	client *HAProxyClient
+}
+
+// mailersResourceModel maps the resource schema data.
+type mailersResourceModel struct {
+	ID      types.String              `tfsdk:"id"`
+	Name    types.String              `tfsdk:"name"`
+	Timeout types.Int64               `tfsdk:"timeout"`
+	Mailers []haproxyMailerEntryModel `tfsdk:"mailer"`
+}
+
+// haproxyMailerEntryModel maps the mailer block schema data.
+type haproxyMailerEntryModel struct {
+	Name    types.String `tfsdk:"name"`
+	Address types.String `tfsdk:"address"`
+	Port    types.Int64  `tfsdk:"port"`
+}
+
+// Metadata returns the resource type name.
+func (r *mailersResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mailers"
+}
+
+// Schema defines the schema for the resource.
+func (r *mailersResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages an HAProxy mailers section and its SMTP servers, used by backend email alerts.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The name of the mailers section.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the mailers section, referenced by the mailers attribute of a backend email_alert.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"timeout": schema.Int64Attribute{
+				Optional:    true,
+				Description: "The time allowed to send an alert, in milliseconds (timeout mail).",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"mailer": schema.ListNestedBlock{
+				Description: "An SMTP server alerts are sent to.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Required:    true,
+							Description: "The name of the mailer.",
+						},
+						"address": schema.StringAttribute{
+							Required:    true,
+							Description: "The IP address of the SMTP server.",
+						},
+						"port": schema.Int64Attribute{
+							Required:    true,
+							Description: "The port of the SMTP server.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *mailersResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+// Create creates the mailers section and its mailer entries in a single transaction.
+func (r *mailersResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan mailersResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := plan.Name.ValueString()
+	err := r.client.InTransaction(func(transactionID string) error {
+		payload := &MailersPayload{Name: name, Timeout: plan.Timeout.ValueInt64()}
+		if err := r.client.CreateMailersInTransaction(ctx, transactionID, payload); err != nil {
+			return err
+		}
+		for _, mailer := range plan.Mailers {
+			if err := r.client.CreateMailerEntryInTransaction(ctx, transactionID, name, mailerEntryToPayload(mailer)); err != nil {
+				return fmt.Errorf("mailer %s: %w", mailer.Name.ValueString(), err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating mailers", fmt.Sprintf("Could not create mailers %s: %s", name, err))
+		return
+	}
+
+	plan.ID = types.StringValue(name)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *mailersResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state mailersResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := state.Name.ValueString()
+	mailers, err := r.client.ReadMailers(ctx, name)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading mailers", fmt.Sprintf("Could not read mailers %s: %s", name, err))
+		return
+	}
+	if mailers == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	entries, err := r.client.ReadMailerEntries(ctx, name)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading mailer entries", fmt.Sprintf("Could not read mailer entries of %s: %s", name, err))
+		return
+	}
+
+	state.ID = types.StringValue(name)
+	state.Timeout = int64OrNull(mailers.Timeout)
+	state.Mailers = mergeMailerEntries(state.Mailers, entries)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Update updates the mailers section and reconciles mailer entries by name in a single transaction.
+func (r *mailersResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state mailersResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := plan.Name.ValueString()
+	existing := make(map[string]haproxyMailerEntryModel, len(state.Mailers))
+	for _, mailer := range state.Mailers {
+		existing[mailer.Name.ValueString()] = mailer
+	}
+	desired := make(map[string]bool, len(plan.Mailers))
+	for _, mailer := range plan.Mailers {
+		desired[mailer.Name.ValueString()] = true
+	}
+
+	err := r.client.InTransaction(func(transactionID string) error {
+		if !plan.Timeout.Equal(state.Timeout) {
+			payload := &MailersPayload{Name: name, Timeout: plan.Timeout.ValueInt64()}
+			if err := r.client.UpdateMailersInTransaction(ctx, transactionID, payload); err != nil {
+				return err
+			}
+		}
+		for mailerName := range existing {
+			if !desired[mailerName] {
+				if err := r.client.DeleteMailerEntryInTransaction(ctx, transactionID, name, mailerName); err != nil {
+					return fmt.Errorf("mailer %s: %w", mailerName, err)
+				}
+			}
+		}
+		for _, mailer := range plan.Mailers {
+			payload := mailerEntryToPayload(mailer)
+			current, exists := existing[payload.Name]
+			switch {
+			case !exists:
+				err := r.client.CreateMailerEntryInTransaction(ctx, transactionID, name, payload)
+				if err != nil {
+					return fmt.Errorf("mailer %s: %w", payload.Name, err)
+				}
+			case !current.Address.Equal(mailer.Address) || !current.Port.Equal(mailer.Port):
+				err := r.client.UpdateMailerEntryInTransaction(ctx, transactionID, name, payload)
+				if err != nil {
+					return fmt.Errorf("mailer %s: %w", payload.Name, err)
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating mailers", fmt.Sprintf("Could not update mailers %s: %s", name, err))
+		return
+	}
+
+	plan.ID = types.StringValue(name)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Delete deletes the mailers section; its mailer entries are removed with it.
+func (r *mailersResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state mailersResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := state.Name.ValueString()
+	err := r.client.InTransaction(func(transactionID string) error {
+		return r.client.DeleteMailersInTransaction(ctx, transactionID, name)
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error deleting mailers", fmt.Sprintf("Could not delete mailers %s: %s", name, err))
+	}
+}
+
+// ImportState imports a mailers section by name.
+func (r *mailersResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), req.ID)...)
+}
+
+// mailerEntryToPayload converts a mailer block to a MailerEntryPayload.
+func mailerEntryToPayload(mailer haproxyMailerEntryModel) *MailerEntryPayload {
+	return &MailerEntryPayload{
+		Name:    mailer.Name.ValueString(),
+		Address: mailer.Address.ValueString(),
+		Port:    mailer.Port.ValueInt64(),
+	}
+}
+
+// mailerEntryFromPayload converts a MailerEntryPayload to a mailer block.
+func mailerEntryFromPayload(mailer MailerEntryPayload) haproxyMailerEntryModel {
+	return haproxyMailerEntryModel{
+		Name:    types.StringValue(mailer.Name),
+		Address: types.StringValue(mailer.Address),
+		Port:    types.Int64Value(mailer.Port),
+	}
+}
+
+// mergeMailerEntries returns the mailer entries read from HAProxy in the order of the current state.
+func mergeMailerEntries(current []haproxyMailerEntryModel, read []MailerEntryPayload) []haproxyMailerEntryModel {
+	byName := make(map[string]MailerEntryPayload, len(read))
+	for _, mailer := range read {
+		byName[mailer.Name] = mailer
+	}
+
+	var result []haproxyMailerEntryModel
+	seen := make(map[string]bool, len(read))
+	for _, mailer := range current {
+		if payload, ok := byName[mailer.Name.ValueString()]; ok {
+			seen[payload.Name] = true
+			result = append(result, mailerEntryFromPayload(payload))
+		}
+	}
+	for _, payload := range read {
+		if !seen[payload.Name] {
+			result = append(result, mailerEntryFromPayload(payload))
+		}
+	}
+	return result
+}
@@ -0,0 +1,189 @@
+package haproxy
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &mapEntryResource{}
+	_ resource.ResourceWithConfigure   = &mapEntryResource{}
+	_ resource.ResourceWithImportState = &mapEntryResource{}
+)
+
+// NewMapEntryResource is a helper function to simplify the provider implementation.
+func NewMapEntryResource() resource.Resource {
+	return &mapEntryResource{}
+}
+
+// mapEntryResource manages a key of a map through the runtime API, without a reload.
+type mapEntryResource struct {
+	client *HAProxyClient
+}
+
+// mapEntryResourceModel maps the resource schema data.
+type mapEntryResourceModel struct {
+	ID    types.String `tfsdk:"id"`
+	Map   types.String `tfsdk:"map"`
+	Key   types.String `tfsdk:"key"`
+	Value types.String `tfsdk:"value"`
+}
+
+// Metadata returns the resource type name.
+func (r *mapEntryResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_map_entry"
+}
+
+// Schema defines the schema for the resource.
+func (r *mapEntryResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a key of a map loaded by HAProxy. Keys are changed through the runtime API without a reload and synced to the map file.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The map and key, in the form <map>/<key>.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"map": schema.StringAttribute{
+				Required:    true,
+				Description: "The storage name of the map file (see haproxy_map_file). The map must be loaded by a map() converter or a map action of the configuration.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"key": schema.StringAttribute{
+				Required:    true,
+				Description: "The key of the entry.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"value": schema.StringAttribute{
+				Required:    true,
+				Description: "The value returned by map() for key.",
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *mapEntryResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+// Create adds the key to the running map.
+func (r *mapEntryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan mapEntryResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	mapName, key := plan.Map.ValueString(), plan.Key.ValueString()
+	payload := &MapEntryPayload{Key: key, Value: plan.Value.ValueString()}
+	if err := r.client.CreateMapEntry(ctx, mapName, payload); err != nil {
+		resp.Diagnostics.AddError("Error creating map entry", fmt.Sprintf("Could not add key %s to map %s: %s", key, mapName, err))
+		return
+	}
+
+	plan.ID = types.StringValue(mapName + "/" + key)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *mapEntryResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state mapEntryResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	mapName, key := state.Map.ValueString(), state.Key.ValueString()
+	entry, err := r.client.ReadMapEntry(ctx, mapName, key)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading map entry", fmt.Sprintf("Could not read key %s of map %s: %s", key, mapName, err))
+		return
+	}
+	if entry == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.ID = types.StringValue(mapName + "/" + key)
+	state.Value = types.StringValue(entry.Value)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Update sets the new value of the key.
+func (r *mapEntryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan mapEntryResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	mapName, key := plan.Map.ValueString(), plan.Key.ValueString()
+	payload := &MapEntryPayload{Key: key, Value: plan.Value.ValueString()}
+	if err := r.client.UpdateMapEntry(ctx, mapName, payload); err != nil {
+		resp.Diagnostics.AddError("Error updating map entry", fmt.Sprintf("Could not update key %s of map %s: %s", key, mapName, err))
+		return
+	}
+
+	plan.ID = types.StringValue(mapName + "/" + key)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Delete removes the key from the running map.
+func (r *mapEntryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state mapEntryResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	mapName, key := state.Map.ValueString(), state.Key.ValueString()
+	if err := r.client.DeleteMapEntry(ctx, mapName, key); err != nil {
+		resp.Diagnostics.AddError("Error deleting map entry", fmt.Sprintf("Could not remove key %s from map %s: %s", key, mapName, err))
+	}
+}
+
+// ImportState imports a map entry from an ID of the form <map>/<key>. Map names cannot
+// contain a slash, so the key is everything after the first one.
+func (r *mapEntryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	mapName, key, ok := strings.Cut(req.ID, "/")
+	if !ok || mapName == "" || key == "" {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			fmt.Sprintf("Expected an import ID of the form <map>/<key>, got: %s", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("map"), mapName)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("key"), key)...)
+}
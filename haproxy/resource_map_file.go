@@ -0,0 +1,193 @@
+package haproxy
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &mapFileResource{}
+	_ resource.ResourceWithConfigure   = &mapFileResource{}
+	_ resource.ResourceWithImportState = &mapFileResource{}
+)
+
+// NewMapFileResource is a helper function to simplify the provider implementation.
+func NewMapFileResource() resource.Resource {
+	return &mapFileResource{}
+}
+
+// mapFileResource manages a file of the Data Plane API map storage.
+type mapFileResource struct {
+	client *HAProxyClient
+}
+
+// mapFileResourceModel maps the resource schema data.
+type mapFileResourceModel struct {
+	ID      types.String `tfsdk:"id"`
+	Name    types.String `tfsdk:"name"`
+	Content types.String `tfsdk:"content"`
+	File    types.String `tfsdk:"file"`
+}
+
+// Metadata returns the resource type name.
+func (r *mapFileResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_map_file"
+}
+
+// Schema defines the schema for the resource.
+func (r *mapFileResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a map file in the Data Plane API map storage, used by map() converters and by haproxy_map_entry.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The storage name of the map file.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The storage name of the map file, e.g. hosts.map.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"content": schema.StringAttribute{
+				Required:    true,
+				Description: "The initial entries of the map, one \"key value\" pair per line. Replacing it drops the entries added by haproxy_map_entry.",
+			},
+			"file": schema.StringAttribute{
+				Computed:    true,
+				Description: "The path of the stored map file on the HAProxy host, to be used in map() converters.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *mapFileResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+// Create uploads the map file to the map storage.
+func (r *mapFileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan mapFileResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := plan.Name.ValueString()
+	file, err := r.client.CreateMapFile(ctx, name, []byte(plan.Content.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating map file", fmt.Sprintf("Could not create map file %s: %s", name, err))
+		return
+	}
+
+	plan.ID = types.StringValue(name)
+	plan.File = r.storedPath(ctx, name, file)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *mapFileResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state mapFileResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := state.Name.ValueString()
+	file, err := r.client.ReadMapFile(ctx, name)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading map file", fmt.Sprintf("Could not read map file %s: %s", name, err))
+		return
+	}
+	if file == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Runtime entries are synced to the file, so its content is expected to differ from
+	// content and is not refreshed
+	state.ID = types.StringValue(name)
+	state.File = stringOrNull(file.File)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Update replaces the content of the stored map file.
+func (r *mapFileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan mapFileResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := plan.Name.ValueString()
+	file, err := r.client.ReplaceMapFile(ctx, name, []byte(plan.Content.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating map file", fmt.Sprintf("Could not update map file %s: %s", name, err))
+		return
+	}
+
+	plan.ID = types.StringValue(name)
+	plan.File = r.storedPath(ctx, name, file)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Delete deletes the stored map file.
+func (r *mapFileResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state mapFileResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := state.Name.ValueString()
+	if err := r.client.DeleteMapFile(ctx, name); err != nil {
+		resp.Diagnostics.AddError("Error deleting map file", fmt.Sprintf("Could not delete map file %s: %s", name, err))
+	}
+}
+
+// ImportState imports a stored map file by name. Its content is not refreshed and must come
+// from the configuration.
+func (r *mapFileResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), req.ID)...)
+}
+
+// storedPath returns the path of the stored map file, falling back to a read when the write
+// response did not include it.
+func (r *mapFileResource) storedPath(ctx context.Context, name string, file *MapFilePayload) types.String {
+	if file.File == "" {
+		if stored, err := r.client.ReadMapFile(ctx, name); err == nil && stored != nil {
+			return stringOrNull(stored.File)
+		}
+	}
+	return stringOrNull(file.File)
+}
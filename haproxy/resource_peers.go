@@ -0,0 +1,289 @@
+package haproxy
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &peersResource{}
+	_ resource.ResourceWithConfigure   = &peersResource{}
+	_ resource.ResourceWithImportState = &peersResource{}
+)
+
+// NewPeersResource is a helper function to simplify the provider implementation.
+func NewPeersResource() resource.Resource {
+	return &peersResource{}
+}
+
+// peersResource manages a peers section and its peer entries.
+type peersResource struct {
+	client *HAProxyClient
+}
+
+// peersResourceModel maps the resource schema data.
+type peersResourceModel struct {
+	ID    types.String            `tfsdk:"id"`
+	Name  types.String            `tfsdk:"name"`
+	Peers []haproxyPeerEntryModel `tfsdk:"peer"`
+}
+
+// haproxyPeerEntryModel maps the peer block schema data.
+type haproxyPeerEntryModel struct {
+	Name    types.String `tfsdk:"name"`
+	Address types.String `tfsdk:"address"`
+	Port    types.Int64  `tfsdk:"port"`
+}
+
+// Metadata returns the resource type name.
+func (r *peersResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_peers"
+}
+
+// Schema defines the schema for the resource.
+func (r *peersResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages an HAProxy peers section and its peer entries, used to replicate stick tables between nodes.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The name of the peers section.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the peers section, referenced by the peers attribute of a backend stick_table.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"peer": schema.ListNestedBlock{
+				Description: "A node taking part in stick table replication. One entry must be named after the local HAProxy instance.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Required:    true,
+							Description: "The name of the peer. It must match the local peer name (usually the hostname) on the node it describes.",
+						},
+						"address": schema.StringAttribute{
+							Required:    true,
+							Description: "The IP address the peer listens on.",
+						},
+						"port": schema.Int64Attribute{
+							Optional:    true,
+							Description: "The port the peer listens on.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *peersResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+// Create creates the peers section and its peer entries in a single transaction.
+func (r *peersResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan peersResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := plan.Name.ValueString()
+	err := r.client.InTransaction(func(transactionID string) error {
+		if err := r.client.CreatePeersInTransaction(ctx, transactionID, &PeersPayload{Name: name}); err != nil {
+			return err
+		}
+		for _, peer := range plan.Peers {
+			if err := r.client.CreatePeerEntryInTransaction(ctx, transactionID, name, peerEntryToPayload(peer)); err != nil {
+				return fmt.Errorf("peer %s: %w", peer.Name.ValueString(), err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating peers", fmt.Sprintf("Could not create peers %s: %s", name, err))
+		return
+	}
+
+	plan.ID = types.StringValue(name)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *peersResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state peersResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := state.Name.ValueString()
+	peers, err := r.client.ReadPeers(ctx, name)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading peers", fmt.Sprintf("Could not read peers %s: %s", name, err))
+		return
+	}
+	if peers == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	entries, err := r.client.ReadPeerEntries(ctx, name)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading peer entries", fmt.Sprintf("Could not read peer entries of %s: %s", name, err))
+		return
+	}
+
+	state.ID = types.StringValue(name)
+	state.Peers = mergePeerEntries(state.Peers, entries)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Update reconciles peer entries by name in a single transaction.
+// The peers section itself has no attributes besides its name, which forces replacement.
+func (r *peersResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state peersResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := plan.Name.ValueString()
+	existing := make(map[string]haproxyPeerEntryModel, len(state.Peers))
+	for _, peer := range state.Peers {
+		existing[peer.Name.ValueString()] = peer
+	}
+	desired := make(map[string]bool, len(plan.Peers))
+	for _, peer := range plan.Peers {
+		desired[peer.Name.ValueString()] = true
+	}
+
+	err := r.client.InTransaction(func(transactionID string) error {
+		for peerName := range existing {
+			if !desired[peerName] {
+				if err := r.client.DeletePeerEntryInTransaction(ctx, transactionID, name, peerName); err != nil {
+					return fmt.Errorf("peer %s: %w", peerName, err)
+				}
+			}
+		}
+		for _, peer := range plan.Peers {
+			payload := peerEntryToPayload(peer)
+			current, exists := existing[payload.Name]
+			switch {
+			case !exists:
+				err := r.client.CreatePeerEntryInTransaction(ctx, transactionID, name, payload)
+				if err != nil {
+					return fmt.Errorf("peer %s: %w", payload.Name, err)
+				}
+			case !current.Address.Equal(peer.Address) || !current.Port.Equal(peer.Port):
+				err := r.client.UpdatePeerEntryInTransaction(ctx, transactionID, name, payload)
+				if err != nil {
+					return fmt.Errorf("peer %s: %w", payload.Name, err)
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating peers", fmt.Sprintf("Could not update peers %s: %s", name, err))
+		return
+	}
+
+	plan.ID = types.StringValue(name)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Delete deletes the peers section; its peer entries are removed with it.
+func (r *peersResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state peersResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := state.Name.ValueString()
+	err := r.client.InTransaction(func(transactionID string) error {
+		return r.client.DeletePeersInTransaction(ctx, transactionID, name)
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error deleting peers", fmt.Sprintf("Could not delete peers %s: %s", name, err))
+	}
+}
+
+// ImportState imports a peers section by name.
+func (r *peersResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), req.ID)...)
+}
+
+// peerEntryToPayload converts a peer block to a PeerEntryPayload.
+func peerEntryToPayload(peer haproxyPeerEntryModel) *PeerEntryPayload {
+	return &PeerEntryPayload{
+		Name:    peer.Name.ValueString(),
+		Address: peer.Address.ValueString(),
+		Port:    peer.Port.ValueInt64(),
+	}
+}
+
+// peerEntryFromPayload converts a PeerEntryPayload to a peer block.
+func peerEntryFromPayload(peer PeerEntryPayload) haproxyPeerEntryModel {
+	return haproxyPeerEntryModel{
+		Name:    types.StringValue(peer.Name),
+		Address: types.StringValue(peer.Address),
+		Port:    int64OrNull(peer.Port),
+	}
+}
+
+// mergePeerEntries returns the peer entries read from HAProxy in the order of the current state.
+func mergePeerEntries(current []haproxyPeerEntryModel, read []PeerEntryPayload) []haproxyPeerEntryModel {
+	byName := make(map[string]PeerEntryPayload, len(read))
+	for _, peer := range read {
+		byName[peer.Name] = peer
+	}
+
+	var result []haproxyPeerEntryModel
+	seen := make(map[string]bool, len(read))
+	for _, peer := range current {
+		if payload, ok := byName[peer.Name.ValueString()]; ok {
+			seen[payload.Name] = true
+			result = append(result, peerEntryFromPayload(payload))
+		}
+	}
+	for _, payload := range read {
+		if !seen[payload.Name] {
+			result = append(result, peerEntryFromPayload(payload))
+		}
+	}
+	return result
+}
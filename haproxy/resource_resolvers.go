@@ -0,0 +1,369 @@
+package haproxy
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &resolversResource{}
+	_ resource.ResourceWithConfigure   = &resolversResource{}
+	_ resource.ResourceWithImportState = &resolversResource{}
+)
+
+// NewResolversResource is a helper function to simplify the provider implementation.
+func NewResolversResource() resource.Resource {
+	return &resolversResource{}
+}
+
+// resolversResource manages a resolvers section and its nameservers.
+type resolversResource struct {
+	client *HAProxyClient
+}
+
+// resolversResourceModel maps the resource schema data.
+type resolversResourceModel struct {
+	ID                  types.String             `tfsdk:"id"`
+	Name                types.String             `tfsdk:"name"`
+	AcceptedPayloadSize types.Int64              `tfsdk:"accepted_payload_size"`
+	HoldNx              types.Int64              `tfsdk:"hold_nx"`
+	HoldObsolete        types.Int64              `tfsdk:"hold_obsolete"`
+	HoldOther           types.Int64              `tfsdk:"hold_other"`
+	HoldRefused         types.Int64              `tfsdk:"hold_refused"`
+	HoldTimeout         types.Int64              `tfsdk:"hold_timeout"`
+	HoldValid           types.Int64              `tfsdk:"hold_valid"`
+	ResolveRetries      types.Int64              `tfsdk:"resolve_retries"`
+	TimeoutResolve      types.Int64              `tfsdk:"timeout_resolve"`
+	TimeoutRetry        types.Int64              `tfsdk:"timeout_retry"`
+	Nameservers         []haproxyNameserverModel `tfsdk:"nameserver"`
+}
+
+// haproxyNameserverModel maps the nameserver block schema data.
+type haproxyNameserverModel struct {
+	Name    types.String `tfsdk:"name"`
+	Address types.String `tfsdk:"address"`
+	Port    types.Int64  `tfsdk:"port"`
+}
+
+// Metadata returns the resource type name.
+func (r *resolversResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_resolvers"
+}
+
+// Schema defines the schema for the resource.
+func (r *resolversResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages an HAProxy resolvers section and its nameservers, used for DNS-based server discovery.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The name of the resolvers section.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the resolvers section, referenced by the resolvers attribute of servers.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"accepted_payload_size": schema.Int64Attribute{
+				Optional:    true,
+				Description: "The maximum DNS response payload size accepted, in bytes.",
+			},
+			"hold_nx": schema.Int64Attribute{
+				Optional:    true,
+				Description: "How long to keep the last valid resolution after an NX domain response, in milliseconds.",
+			},
+			"hold_obsolete": schema.Int64Attribute{
+				Optional:    true,
+				Description: "How long to keep a server that disappeared from the response, in milliseconds.",
+			},
+			"hold_other": schema.Int64Attribute{
+				Optional:    true,
+				Description: "How long to keep the last valid resolution after any other error, in milliseconds.",
+			},
+			"hold_refused": schema.Int64Attribute{
+				Optional:    true,
+				Description: "How long to keep the last valid resolution after a refused response, in milliseconds.",
+			},
+			"hold_timeout": schema.Int64Attribute{
+				Optional:    true,
+				Description: "How long to keep the last valid resolution after a timeout, in milliseconds.",
+			},
+			"hold_valid": schema.Int64Attribute{
+				Optional:    true,
+				Description: "How long to keep a valid resolution, in milliseconds.",
+			},
+			"resolve_retries": schema.Int64Attribute{
+				Optional:    true,
+				Description: "The number of queries to send before giving up.",
+			},
+			"timeout_resolve": schema.Int64Attribute{
+				Optional:    true,
+				Description: "The time between two DNS queries when no valid response is held, in milliseconds.",
+			},
+			"timeout_retry": schema.Int64Attribute{
+				Optional:    true,
+				Description: "The time between two DNS queries when no response was received, in milliseconds.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"nameserver": schema.ListNestedBlock{
+				Description: "A DNS server queried by this resolvers section.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Required:    true,
+							Description: "The name of the nameserver.",
+						},
+						"address": schema.StringAttribute{
+							Required:    true,
+							Description: "The IP address of the nameserver.",
+						},
+						"port": schema.Int64Attribute{
+							Optional:    true,
+							Description: "The port of the nameserver (HAProxy defaults to 53).",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *resolversResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+// Create creates the resolvers section and its nameservers in a single transaction.
+func (r *resolversResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan resolversResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := plan.Name.ValueString()
+	err := r.client.InTransaction(func(transactionID string) error {
+		if err := r.client.CreateResolverInTransaction(ctx, transactionID, r.toPayload(&plan)); err != nil {
+			return err
+		}
+		for _, nameserver := range plan.Nameservers {
+			if err := r.client.CreateNameserverInTransaction(ctx, transactionID, name, nameserverToPayload(nameserver)); err != nil {
+				return fmt.Errorf("nameserver %s: %w", nameserver.Name.ValueString(), err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating resolvers", fmt.Sprintf("Could not create resolvers %s: %s", name, err))
+		return
+	}
+
+	plan.ID = types.StringValue(name)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *resolversResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state resolversResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := state.Name.ValueString()
+	resolver, err := r.client.ReadResolver(ctx, name)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading resolvers", fmt.Sprintf("Could not read resolvers %s: %s", name, err))
+		return
+	}
+	if resolver == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	nameservers, err := r.client.ReadNameservers(ctx, name)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading nameservers", fmt.Sprintf("Could not read nameservers of %s: %s", name, err))
+		return
+	}
+
+	state.ID = types.StringValue(name)
+	state.AcceptedPayloadSize = int64OrNull(resolver.AcceptedPayloadSize)
+	state.HoldNx = int64OrNull(resolver.HoldNx)
+	state.HoldObsolete = int64OrNull(resolver.HoldObsolete)
+	state.HoldOther = int64OrNull(resolver.HoldOther)
+	state.HoldRefused = int64OrNull(resolver.HoldRefused)
+	state.HoldTimeout = int64OrNull(resolver.HoldTimeout)
+	state.HoldValid = int64OrNull(resolver.HoldValid)
+	state.ResolveRetries = int64OrNull(resolver.ResolveRetries)
+	state.TimeoutResolve = int64OrNull(resolver.TimeoutResolve)
+	state.TimeoutRetry = int64OrNull(resolver.TimeoutRetry)
+	state.Nameservers = mergeNameservers(state.Nameservers, nameservers)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Update updates the resolvers section and reconciles nameservers by name in a single transaction.
+func (r *resolversResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state resolversResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := plan.Name.ValueString()
+	existing := make(map[string]haproxyNameserverModel, len(state.Nameservers))
+	for _, nameserver := range state.Nameservers {
+		existing[nameserver.Name.ValueString()] = nameserver
+	}
+	desired := make(map[string]bool, len(plan.Nameservers))
+	for _, nameserver := range plan.Nameservers {
+		desired[nameserver.Name.ValueString()] = true
+	}
+
+	err := r.client.InTransaction(func(transactionID string) error {
+		if err := r.client.UpdateResolverInTransaction(ctx, transactionID, name, r.toPayload(&plan)); err != nil {
+			return err
+		}
+		for nameserverName := range existing {
+			if !desired[nameserverName] {
+				if err := r.client.DeleteNameserverInTransaction(ctx, transactionID, name, nameserverName); err != nil {
+					return fmt.Errorf("nameserver %s: %w", nameserverName, err)
+				}
+			}
+		}
+		for _, nameserver := range plan.Nameservers {
+			payload := nameserverToPayload(nameserver)
+			current, exists := existing[payload.Name]
+			switch {
+			case !exists:
+				err := r.client.CreateNameserverInTransaction(ctx, transactionID, name, payload)
+				if err != nil {
+					return fmt.Errorf("nameserver %s: %w", payload.Name, err)
+				}
+			case !current.Address.Equal(nameserver.Address) || !current.Port.Equal(nameserver.Port):
+				err := r.client.UpdateNameserverInTransaction(ctx, transactionID, name, payload)
+				if err != nil {
+					return fmt.Errorf("nameserver %s: %w", payload.Name, err)
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating resolvers", fmt.Sprintf("Could not update resolvers %s: %s", name, err))
+		return
+	}
+
+	plan.ID = types.StringValue(name)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Delete deletes the resolvers section; its nameservers are removed with it.
+func (r *resolversResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state resolversResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := state.Name.ValueString()
+	err := r.client.InTransaction(func(transactionID string) error {
+		return r.client.DeleteResolverInTransaction(ctx, transactionID, name)
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error deleting resolvers", fmt.Sprintf("Could not delete resolvers %s: %s", name, err))
+	}
+}
+
+// ImportState imports a resolvers section by name.
+func (r *resolversResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), req.ID)...)
+}
+
+// toPayload converts the resource model to a ResolverPayload.
+func (r *resolversResource) toPayload(model *resolversResourceModel) *ResolverPayload {
+	return &ResolverPayload{
+		Name:                model.Name.ValueString(),
+		AcceptedPayloadSize: model.AcceptedPayloadSize.ValueInt64(),
+		HoldNx:              model.HoldNx.ValueInt64(),
+		HoldObsolete:        model.HoldObsolete.ValueInt64(),
+		HoldOther:           model.HoldOther.ValueInt64(),
+		HoldRefused:         model.HoldRefused.ValueInt64(),
+		HoldTimeout:         model.HoldTimeout.ValueInt64(),
+		HoldValid:           model.HoldValid.ValueInt64(),
+		ResolveRetries:      model.ResolveRetries.ValueInt64(),
+		TimeoutResolve:      model.TimeoutResolve.ValueInt64(),
+		TimeoutRetry:        model.TimeoutRetry.ValueInt64(),
+	}
+}
+
+// nameserverToPayload converts a nameserver block to a NameserverPayload.
+func nameserverToPayload(nameserver haproxyNameserverModel) *NameserverPayload {
+	return &NameserverPayload{
+		Name:    nameserver.Name.ValueString(),
+		Address: nameserver.Address.ValueString(),
+		Port:    nameserver.Port.ValueInt64(),
+	}
+}
+
+// nameserverFromPayload converts a NameserverPayload to a nameserver block.
+func nameserverFromPayload(nameserver NameserverPayload) haproxyNameserverModel {
+	return haproxyNameserverModel{
+		Name:    types.StringValue(nameserver.Name),
+		Address: types.StringValue(nameserver.Address),
+		Port:    int64OrNull(nameserver.Port),
+	}
+}
+
+// mergeNameservers returns the nameservers read from HAProxy, keeping the order of the
+// current state so that reordering in HAProxy does not show up as a diff.
+func mergeNameservers(current []haproxyNameserverModel, read []NameserverPayload) []haproxyNameserverModel {
+	byName := make(map[string]NameserverPayload, len(read))
+	for _, nameserver := range read {
+		byName[nameserver.Name] = nameserver
+	}
+
+	var result []haproxyNameserverModel
+	seen := make(map[string]bool, len(read))
+	for _, nameserver := range current {
+		if payload, ok := byName[nameserver.Name.ValueString()]; ok {
+			seen[payload.Name] = true
+			result = append(result, nameserverFromPayload(payload))
+		}
+	}
+	for _, payload := range read {
+		if !seen[payload.Name] {
+			result = append(result, nameserverFromPayload(payload))
+		}
+	}
+	return result
+}
@@ -0,0 +1,237 @@
+package haproxy
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &serverResource{}
+	_ resource.ResourceWithConfigure   = &serverResource{}
+	_ resource.ResourceWithImportState = &serverResource{}
+)
+
+// NewServerResource is a helper function to simplify the provider implementation.
+func NewServerResource() resource.Resource {
+	return &serverResource{}
+}
+
+// serverResource manages a single server attached to a backend.
+type serverResource struct {
+	client *HAProxyClient
+	ops    *StackOperations
+}
+
+// serverResourceModel maps the resource schema data.
+// The server fields are shared with the servers map of haproxy_stack.
+type serverResourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	ParentType types.String `tfsdk:"parent_type"`
+	ParentName types.String `tfsdk:"parent_name"`
+	Name       types.String `tfsdk:"name"`
+	haproxyServerModel
+}
+
+// Metadata returns the resource type name.
+func (r *serverResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_server"
+}
+
+// Schema defines the schema for the resource.
+func (r *serverResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := GetServerAttributes()
+	for name, attribute := range GetParentAttributes("backend") {
+		attributes[name] = attribute
+	}
+	attributes["name"] = schema.StringAttribute{
+		Required:    true,
+		Description: "The name of the server.",
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Manages a single server on a backend. Servers created this way are ignored by an haproxy_stack that owns the same backend.",
+		Attributes:  attributes,
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *serverResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+	r.ops = &StackOperations{client: providerData.Client}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *serverResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan serverResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	parentType := plan.ParentType.ValueString()
+	parentName := plan.ParentName.ValueString()
+	payload := r.ops.convertServerModelToPayload(plan.Name.ValueString(), plan.haproxyServerModel)
+
+	err := r.client.InTransaction(func(transactionID string) error {
+		return r.client.CreateServerInTransaction(ctx, transactionID, parentType, parentName, payload)
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating server",
+			fmt.Sprintf("Could not create server %s on %s %s: %s", payload.Name, parentType, parentName, err),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(fmt.Sprintf("%s/%s/%s", parentType, parentName, payload.Name))
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *serverResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state serverResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	servers, err := r.client.ReadServers(ctx, state.ParentType.ValueString(), state.ParentName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading servers",
+			fmt.Sprintf("Could not read servers: %s", err),
+		)
+		return
+	}
+
+	var found *ServerPayload
+	for i := range servers {
+		if servers[i].Name == state.Name.ValueString() {
+			found = &servers[i]
+			break
+		}
+	}
+	if found == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	server := r.ops.convertServerPayloadToModel(*found)
+
+	// Preserve user-configured values for fields HAProxy doesn't return
+	existing := state.haproxyServerModel
+	if !existing.ForceSslv3.IsNull() {
+		server.ForceSslv3 = existing.ForceSslv3
+	}
+	if !existing.ForceTlsv10.IsNull() {
+		server.ForceTlsv10 = existing.ForceTlsv10
+	}
+	if !existing.ForceTlsv11.IsNull() {
+		server.ForceTlsv11 = existing.ForceTlsv11
+	}
+	if !existing.ForceTlsv12.IsNull() {
+		server.ForceTlsv12 = existing.ForceTlsv12
+	}
+	if !existing.ForceTlsv13.IsNull() {
+		server.ForceTlsv13 = existing.ForceTlsv13
+	}
+	if !existing.SslCertificate.IsNull() {
+		server.SslCertificate = existing.SslCertificate
+	}
+	if !existing.SslMaxVer.IsNull() {
+		server.SslMaxVer = existing.SslMaxVer
+	}
+	if !existing.SslMinVer.IsNull() {
+		server.SslMinVer = existing.SslMinVer
+	}
+
+	state.haproxyServerModel = server
+	state.ID = types.StringValue(fmt.Sprintf("%s/%s/%s", state.ParentType.ValueString(), state.ParentName.ValueString(), state.Name.ValueString()))
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *serverResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan serverResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	parentType := plan.ParentType.ValueString()
+	parentName := plan.ParentName.ValueString()
+	payload := r.ops.convertServerModelToPayload(plan.Name.ValueString(), plan.haproxyServerModel)
+
+	err := r.client.InTransaction(func(transactionID string) error {
+		return r.client.UpdateServerInTransaction(ctx, transactionID, parentType, parentName, payload)
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating server",
+			fmt.Sprintf("Could not update server %s on %s %s: %s", payload.Name, parentType, parentName, err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *serverResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state serverResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	parentType := state.ParentType.ValueString()
+	parentName := state.ParentName.ValueString()
+	name := state.Name.ValueString()
+
+	err := r.client.InTransaction(func(transactionID string) error {
+		return r.client.DeleteServerInTransaction(ctx, transactionID, parentType, parentName, name)
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting server",
+			fmt.Sprintf("Could not delete server %s on %s %s: %s", name, parentType, parentName, err),
+		)
+	}
+}
+
+// ImportState imports a server using the ID format parent_type/parent_name/name.
+func (r *serverResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parentType, parentName, name, err := parseChildImportID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("parent_type"), parentType)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("parent_name"), parentName)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
+}
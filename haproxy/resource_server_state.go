@@ -0,0 +1,230 @@
+package haproxy
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &serverStateResource{}
+	_ resource.ResourceWithConfigure   = &serverStateResource{}
+	_ resource.ResourceWithImportState = &serverStateResource{}
+)
+
+// NewServerStateResource is a helper function to simplify the provider implementation.
+func NewServerStateResource() resource.Resource {
+	return &serverStateResource{}
+}
+
+// serverStateResource manages the runtime state of a server, without a configuration transaction.
+type serverStateResource struct {
+	client *HAProxyClient
+}
+
+// serverStateResourceModel maps the resource schema data.
+type serverStateResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	ParentType       types.String `tfsdk:"parent_type"`
+	ParentName       types.String `tfsdk:"parent_name"`
+	Name             types.String `tfsdk:"name"`
+	AdminState       types.String `tfsdk:"admin_state"`
+	Weight           types.Int64  `tfsdk:"weight"`
+	OperationalState types.String `tfsdk:"operational_state"`
+}
+
+// Metadata returns the resource type name.
+func (r *serverStateResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_server_state"
+}
+
+// Schema defines the schema for the resource.
+func (r *serverStateResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := GetParentAttributes("backend")
+	attributes["name"] = schema.StringAttribute{
+		Required:    true,
+		Description: "The name of the server.",
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+	attributes["admin_state"] = schema.StringAttribute{
+		Required:    true,
+		Description: "The administrative state of the server: ready, drain (no new sessions) or maint (no traffic).",
+		Validators: []validator.String{
+			stringvalidator.OneOf("ready", "drain", "maint"),
+		},
+	}
+	attributes["weight"] = schema.Int64Attribute{
+		Optional:    true,
+		Description: "The runtime weight of the server. The configured weight is kept when unset.",
+		Validators: []validator.Int64{
+			int64validator.Between(0, 256),
+		},
+	}
+	attributes["operational_state"] = schema.StringAttribute{
+		Computed:    true,
+		Description: "The operational state of the server as reported by its health checks (up, down or stopping).",
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Manages the runtime administrative state and weight of a server through the runtime API, without a configuration transaction or a reload. The state is reset by the next reload and set again on the following apply. Deleting the resource sets the server back to ready.",
+		Attributes:  attributes,
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *serverStateResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+// Create sets the runtime state of the server.
+func (r *serverStateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan serverStateResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	backend, name := plan.ParentName.ValueString(), plan.Name.ValueString()
+	server, err := r.apply(ctx, &plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Error setting server state", fmt.Sprintf("Could not set the state of server %s on backend %s: %s", name, backend, err))
+		return
+	}
+
+	plan.ID = types.StringValue(fmt.Sprintf("backend/%s/%s", backend, name))
+	plan.OperationalState = stringOrNull(server.OperationalState)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *serverStateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state serverStateResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	backend, name := state.ParentName.ValueString(), state.Name.ValueString()
+	server, err := r.client.ReadRuntimeServer(ctx, backend, name)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading server state", fmt.Sprintf("Could not read the state of server %s on backend %s: %s", name, backend, err))
+		return
+	}
+	if server == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.ID = types.StringValue(fmt.Sprintf("backend/%s/%s", backend, name))
+	state.AdminState = types.StringValue(server.AdminState)
+	state.OperationalState = stringOrNull(server.OperationalState)
+	// The weight is only tracked when managed, and only if the API reports it
+	if !state.Weight.IsNull() && server.Weight != nil {
+		state.Weight = types.Int64Value(*server.Weight)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Update sets the new runtime state of the server.
+func (r *serverStateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan serverStateResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	backend, name := plan.ParentName.ValueString(), plan.Name.ValueString()
+	server, err := r.apply(ctx, &plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Error setting server state", fmt.Sprintf("Could not set the state of server %s on backend %s: %s", name, backend, err))
+		return
+	}
+
+	plan.ID = types.StringValue(fmt.Sprintf("backend/%s/%s", backend, name))
+	plan.OperationalState = stringOrNull(server.OperationalState)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Delete sets the server back to ready. A server that no longer exists is left alone.
+func (r *serverStateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state serverStateResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	backend, name := state.ParentName.ValueString(), state.Name.ValueString()
+	server, err := r.client.ReadRuntimeServer(ctx, backend, name)
+	if err != nil {
+		resp.Diagnostics.AddError("Error resetting server state", fmt.Sprintf("Could not read the state of server %s on backend %s: %s", name, backend, err))
+		return
+	}
+	if server == nil || server.AdminState == "ready" {
+		return
+	}
+
+	if err := r.client.UpdateRuntimeServer(ctx, backend, name, &RuntimeServerPayload{AdminState: "ready"}); err != nil {
+		resp.Diagnostics.AddError("Error resetting server state", fmt.Sprintf("Could not set server %s on backend %s back to ready: %s", name, backend, err))
+	}
+}
+
+// ImportState imports the runtime state of a server from an ID of the form backend/<backend>/<server>.
+func (r *serverStateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parentType, parentName, name, err := parseChildImportID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("parent_type"), parentType)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("parent_name"), parentName)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
+}
+
+// apply sends the planned admin state and weight, then reads the resulting server state.
+func (r *serverStateResource) apply(ctx context.Context, plan *serverStateResourceModel) (*RuntimeServerPayload, error) {
+	backend, name := plan.ParentName.ValueString(), plan.Name.ValueString()
+	payload := &RuntimeServerPayload{AdminState: plan.AdminState.ValueString()}
+	if !plan.Weight.IsNull() && !plan.Weight.IsUnknown() {
+		weight := plan.Weight.ValueInt64()
+		payload.Weight = &weight
+	}
+	if err := r.client.UpdateRuntimeServer(ctx, backend, name, payload); err != nil {
+		return nil, err
+	}
+
+	server, err := r.client.ReadRuntimeServer(ctx, backend, name)
+	if err != nil {
+		return nil, err
+	}
+	if server == nil {
+		return nil, fmt.Errorf("server not found in the running process")
+	}
+	return server, nil
+}
@@ -0,0 +1,260 @@
+package haproxy
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                   = &sslCertificateResource{}
+	_ resource.ResourceWithConfigure      = &sslCertificateResource{}
+	_ resource.ResourceWithImportState    = &sslCertificateResource{}
+	_ resource.ResourceWithValidateConfig = &sslCertificateResource{}
+)
+
+// NewSslCertificateResource is a helper function to simplify the provider implementation.
+func NewSslCertificateResource() resource.Resource {
+	return &sslCertificateResource{}
+}
+
+// sslCertificateResource manages a PEM file of the Data Plane API certificate storage.
+type sslCertificateResource struct {
+	client *HAProxyClient
+}
+
+// sslCertificateResourceModel maps the resource schema data.
+type sslCertificateResourceModel struct {
+	ID       types.String   `tfsdk:"id"`
+	Name     types.String   `tfsdk:"name"`
+	Content  types.String   `tfsdk:"content"`
+	File     types.String   `tfsdk:"file"`
+	Subject  types.String   `tfsdk:"subject"`
+	Sans     []types.String `tfsdk:"sans"`
+	NotAfter types.String   `tfsdk:"not_after"`
+}
+
+// Metadata returns the resource type name.
+func (r *sslCertificateResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ssl_certificate"
+}
+
+// Schema defines the schema for the resource.
+func (r *sslCertificateResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a PEM file in the Data Plane API certificate storage, referenced by the ssl_certificate and ssl_cafile attributes of binds and servers.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The storage name of the certificate.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The storage name of the certificate, e.g. site.pem.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"content": schema.StringAttribute{
+				Required:    true,
+				Sensitive:   true,
+				Description: "The PEM content: the certificate, its chain and, for bind certificates, the private key.",
+			},
+			"file": schema.StringAttribute{
+				Computed:    true,
+				Description: "The path of the stored PEM file on the HAProxy host, to be used as ssl_certificate or ssl_cafile.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"subject": schema.StringAttribute{
+				Computed:    true,
+				Description: "The subject of the first certificate in content.",
+			},
+			"sans": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "The DNS names and IP addresses of the first certificate in content.",
+			},
+			"not_after": schema.StringAttribute{
+				Computed:    true,
+				Description: "The expiry of the first certificate in content, in RFC 3339 format.",
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *sslCertificateResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+// ValidateConfig checks that content holds a parsable certificate.
+func (r *sslCertificateResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var content types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("content"), &content)...)
+	if resp.Diagnostics.HasError() || content.IsNull() || content.IsUnknown() {
+		return
+	}
+
+	if _, err := parseCertificate(content.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("content"), "Invalid certificate", err.Error())
+	}
+}
+
+// Create uploads the PEM file to the certificate storage.
+func (r *sslCertificateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan sslCertificateResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := plan.Name.ValueString()
+	certificate, err := r.client.CreateSslCertificate(ctx, name, []byte(plan.Content.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating SSL certificate", fmt.Sprintf("Could not create SSL certificate %s: %s", name, err))
+		return
+	}
+
+	plan.ID = types.StringValue(name)
+	r.setComputed(ctx, &plan, certificate)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *sslCertificateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state sslCertificateResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := state.Name.ValueString()
+	certificate, err := r.client.ReadSslCertificate(ctx, name)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading SSL certificate", fmt.Sprintf("Could not read SSL certificate %s: %s", name, err))
+		return
+	}
+	if certificate == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// The storage does not return the PEM content, so metadata is only refreshed from it
+	state.ID = types.StringValue(name)
+	state.File = stringOrNull(certificate.File)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Update replaces the content of the stored PEM file.
+func (r *sslCertificateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan sslCertificateResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := plan.Name.ValueString()
+	certificate, err := r.client.ReplaceSslCertificate(ctx, name, []byte(plan.Content.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating SSL certificate", fmt.Sprintf("Could not update SSL certificate %s: %s", name, err))
+		return
+	}
+
+	plan.ID = types.StringValue(name)
+	r.setComputed(ctx, &plan, certificate)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Delete deletes the stored PEM file.
+func (r *sslCertificateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state sslCertificateResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := state.Name.ValueString()
+	if err := r.client.DeleteSslCertificate(ctx, name); err != nil {
+		resp.Diagnostics.AddError("Error deleting SSL certificate", fmt.Sprintf("Could not delete SSL certificate %s: %s", name, err))
+	}
+}
+
+// ImportState imports a stored certificate by name. Its content is not returned by the
+// storage and must come from the configuration.
+func (r *sslCertificateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), req.ID)...)
+}
+
+// setComputed sets the stored path and the metadata parsed from content. The path falls back
+// to a read when the write response did not include it.
+func (r *sslCertificateResource) setComputed(ctx context.Context, model *sslCertificateResourceModel, certificate *SslCertificatePayload) {
+	file := certificate.File
+	if file == "" {
+		if stored, err := r.client.ReadSslCertificate(ctx, model.Name.ValueString()); err == nil && stored != nil {
+			file = stored.File
+		}
+	}
+	model.File = stringOrNull(file)
+
+	model.Subject = types.StringNull()
+	model.NotAfter = types.StringNull()
+	model.Sans = nil
+	cert, err := parseCertificate(model.Content.ValueString())
+	if err != nil {
+		return
+	}
+
+	model.Sans = []types.String{}
+	for _, name := range cert.DNSNames {
+		model.Sans = append(model.Sans, types.StringValue(name))
+	}
+	for _, ip := range cert.IPAddresses {
+		model.Sans = append(model.Sans, types.StringValue(ip.String()))
+	}
+	model.Subject = types.StringValue(cert.Subject.String())
+	model.NotAfter = types.StringValue(cert.NotAfter.UTC().Format(time.RFC3339))
+}
+
+// parseCertificate returns the first certificate of a PEM bundle.
+func parseCertificate(content string) (*x509.Certificate, error) {
+	rest := []byte(content)
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			return nil, fmt.Errorf("content does not contain a PEM encoded CERTIFICATE block")
+		}
+		if block.Type == "CERTIFICATE" {
+			return x509.ParseCertificate(block.Bytes)
+		}
+	}
+}
@@ -0,0 +1,234 @@
+package haproxy
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &stickTableResource{}
+	_ resource.ResourceWithConfigure   = &stickTableResource{}
+	_ resource.ResourceWithImportState = &stickTableResource{}
+)
+
+// NewStickTableResource is a helper function to simplify the provider implementation.
+func NewStickTableResource() resource.Resource {
+	return &stickTableResource{}
+}
+
+// stickTableResource manages a standalone stick table, shared by name across stacks.
+type stickTableResource struct {
+	client *HAProxyClient
+}
+
+// stickTableResourceModel maps the resource schema data.
+type stickTableResourceModel struct {
+	ID      types.String `tfsdk:"id"`
+	Name    types.String `tfsdk:"name"`
+	Type    types.String `tfsdk:"type"`
+	Size    types.Int64  `tfsdk:"size"`
+	Keylen  types.Int64  `tfsdk:"keylen"`
+	Expire  types.Int64  `tfsdk:"expire"`
+	Store   types.String `tfsdk:"store"`
+	Peers   types.String `tfsdk:"peers"`
+	Nopurge types.Bool   `tfsdk:"nopurge"`
+}
+
+// Metadata returns the resource type name.
+func (r *stickTableResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_stick_table"
+}
+
+// Schema defines the schema for the resource.
+func (r *stickTableResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a standalone HAProxy stick table, declared in a backend section that holds nothing else. Rules in any stack can track into it by name.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The name of the stick table.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the stick table, referenced by the track_sc_table attribute of tcp_request_rules and http_request_rules.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				Required:    true,
+				Description: "The type of the keys (ip, ipv6, integer, string, binary).",
+			},
+			"size": schema.Int64Attribute{
+				Required:    true,
+				Description: "The maximum number of entries.",
+			},
+			"keylen": schema.Int64Attribute{
+				Optional:    true,
+				Description: "The length of string and binary keys, in bytes.",
+			},
+			"expire": schema.Int64Attribute{
+				Optional:    true,
+				Description: "The expiration time of unused entries in milliseconds.",
+			},
+			"store": schema.StringAttribute{
+				Optional:    true,
+				Description: "The comma-separated data types stored with each entry, e.g. conn_cur,http_req_rate(10s).",
+			},
+			"peers": schema.StringAttribute{
+				Optional:    true,
+				Description: "The name of the peers section (see haproxy_peers) the stick table is replicated to.",
+			},
+			"nopurge": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether to keep entries instead of purging the oldest ones when the table is full.",
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *stickTableResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+// Create creates the stick table.
+func (r *stickTableResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan stickTableResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := plan.Name.ValueString()
+	err := r.client.InTransaction(func(transactionID string) error {
+		return r.client.CreateStickTableInTransaction(ctx, transactionID, r.toPayload(&plan))
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating stick table", fmt.Sprintf("Could not create stick table %s: %s", name, err))
+		return
+	}
+
+	plan.ID = types.StringValue(name)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *stickTableResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state stickTableResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := state.Name.ValueString()
+	stickTable, err := r.client.ReadStickTable(ctx, name)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading stick table", fmt.Sprintf("Could not read stick table %s: %s", name, err))
+		return
+	}
+	if stickTable == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	table := stickTable.StickTable
+	if table == nil {
+		// The section exists but its stick-table was removed outside of Terraform.
+		table = &BackendStickTable{}
+	}
+
+	state.ID = types.StringValue(name)
+	state.Type = stringOrNull(table.Type)
+	state.Size = int64OrNull(table.Size)
+	state.Keylen = int64OrNull(table.Keylen)
+	state.Expire = int64OrNull(table.Expire)
+	state.Store = stringOrNull(table.Store)
+	state.Peers = stringOrNull(table.Peers)
+	state.Nopurge = boolOrPrior(table.Nopurge, state.Nopurge)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Update updates the stick table in place.
+func (r *stickTableResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan stickTableResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := plan.Name.ValueString()
+	err := r.client.InTransaction(func(transactionID string) error {
+		return r.client.UpdateStickTableInTransaction(ctx, transactionID, r.toPayload(&plan))
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating stick table", fmt.Sprintf("Could not update stick table %s: %s", name, err))
+		return
+	}
+
+	plan.ID = types.StringValue(name)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Delete deletes the stick table.
+func (r *stickTableResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state stickTableResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := state.Name.ValueString()
+	err := r.client.InTransaction(func(transactionID string) error {
+		return r.client.DeleteStickTableInTransaction(ctx, transactionID, name)
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error deleting stick table", fmt.Sprintf("Could not delete stick table %s: %s", name, err))
+	}
+}
+
+// ImportState imports a stick table by name.
+func (r *stickTableResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), req.ID)...)
+}
+
+// toPayload converts the resource model to a StickTablePayload.
+func (r *stickTableResource) toPayload(model *stickTableResourceModel) *StickTablePayload {
+	return &StickTablePayload{
+		Name: model.Name.ValueString(),
+		StickTable: &BackendStickTable{
+			Type:    model.Type.ValueString(),
+			Size:    model.Size.ValueInt64(),
+			Keylen:  model.Keylen.ValueInt64(),
+			Expire:  model.Expire.ValueInt64(),
+			Store:   model.Store.ValueString(),
+			Peers:   model.Peers.ValueString(),
+			Nopurge: model.Nopurge.ValueBool(),
+		},
+	}
+}
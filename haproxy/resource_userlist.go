@@ -0,0 +1,450 @@
+package haproxy
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                   = &userlistResource{}
+	_ resource.ResourceWithConfigure      = &userlistResource{}
+	_ resource.ResourceWithImportState    = &userlistResource{}
+	_ resource.ResourceWithValidateConfig = &userlistResource{}
+)
+
+// NewUserlistResource is a helper function to simplify the provider implementation.
+func NewUserlistResource() resource.Resource {
+	return &userlistResource{}
+}
+
+// userlistResource manages a userlist section with its users and groups.
+type userlistResource struct {
+	client *HAProxyClient
+}
+
+// userlistResourceModel maps the resource schema data.
+type userlistResourceModel struct {
+	ID     types.String                `tfsdk:"id"`
+	Name   types.String                `tfsdk:"name"`
+	Users  []haproxyUserlistUserModel  `tfsdk:"user"`
+	Groups []haproxyUserlistGroupModel `tfsdk:"group"`
+}
+
+// haproxyUserlistUserModel maps the user block schema data.
+type haproxyUserlistUserModel struct {
+	Username          types.String `tfsdk:"username"`
+	PasswordWO        types.String `tfsdk:"password_wo"`
+	PasswordWOVersion types.Int64  `tfsdk:"password_wo_version"`
+	PasswordHash      types.String `tfsdk:"password_hash"`
+}
+
+// haproxyUserlistGroupModel maps the group block schema data.
+type haproxyUserlistGroupModel struct {
+	Name  types.String   `tfsdk:"name"`
+	Users []types.String `tfsdk:"users"`
+}
+
+// Metadata returns the resource type name.
+func (r *userlistResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_userlist"
+}
+
+// Schema defines the schema for the resource.
+func (r *userlistResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages an HAProxy userlist section with its users and groups, used for HTTP basic authentication.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The name of the userlist.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the userlist, referenced by http_auth and http_auth_group ACL criteria.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"user": schema.ListNestedBlock{
+				Description: "A user of the userlist. Exactly one of password_wo and password_hash must be set.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"username": schema.StringAttribute{
+							Required:    true,
+							Description: "The name of the user.",
+						},
+						"password_wo": schema.StringAttribute{
+							Optional:    true,
+							Sensitive:   true,
+							WriteOnly:   true,
+							Description: "The plain-text password, written as an insecure-password and never stored in the Terraform state. Change password_wo_version to send a new value.",
+						},
+						"password_wo_version": schema.Int64Attribute{
+							Optional:    true,
+							Description: "A version number for password_wo; changing it updates the password on HAProxy.",
+						},
+						"password_hash": schema.StringAttribute{
+							Optional:    true,
+							Sensitive:   true,
+							Description: "A crypt(3) password hash, e.g. generated with mkpasswd -m sha-512.",
+						},
+					},
+				},
+			},
+			"group": schema.ListNestedBlock{
+				Description: "A group of users, referenced by http_auth_group ACL criteria.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Required:    true,
+							Description: "The name of the group.",
+						},
+						"users": schema.ListAttribute{
+							Optional:    true,
+							ElementType: types.StringType,
+							Description: "The users belonging to the group.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig checks that every user declares exactly one kind of password.
+func (r *userlistResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config userlistResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for i, user := range config.Users {
+		if user.PasswordWO.IsUnknown() || user.PasswordHash.IsUnknown() {
+			continue
+		}
+		if user.PasswordWO.IsNull() == user.PasswordHash.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("user").AtListIndex(i),
+				"Invalid user password",
+				fmt.Sprintf("User %s must set exactly one of password_wo and password_hash.", user.Username.ValueString()),
+			)
+		}
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *userlistResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+// Create creates the userlist with its users and groups in a single transaction.
+func (r *userlistResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan, config userlistResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := plan.Name.ValueString()
+	passwords := writeOnlyPasswords(config.Users)
+	err := r.client.InTransaction(func(transactionID string) error {
+		if err := r.client.CreateUserlistInTransaction(ctx, transactionID, &UserlistPayload{Name: name}); err != nil {
+			return err
+		}
+		for _, user := range plan.Users {
+			if err := r.client.CreateUserInTransaction(ctx, transactionID, name, userToPayload(user, passwords)); err != nil {
+				return fmt.Errorf("user %s: %w", user.Username.ValueString(), err)
+			}
+		}
+		for _, group := range plan.Groups {
+			if err := r.client.CreateGroupInTransaction(ctx, transactionID, name, groupToPayload(group)); err != nil {
+				return fmt.Errorf("group %s: %w", group.Name.ValueString(), err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating userlist", fmt.Sprintf("Could not create userlist %s: %s", name, err))
+		return
+	}
+
+	plan.ID = types.StringValue(name)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *userlistResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state userlistResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := state.Name.ValueString()
+	userlist, err := r.client.ReadUserlist(ctx, name)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading userlist", fmt.Sprintf("Could not read userlist %s: %s", name, err))
+		return
+	}
+	if userlist == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	users, err := r.client.ReadUsers(ctx, name)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading users", fmt.Sprintf("Could not read users of %s: %s", name, err))
+		return
+	}
+	groups, err := r.client.ReadGroups(ctx, name)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading groups", fmt.Sprintf("Could not read groups of %s: %s", name, err))
+		return
+	}
+
+	state.ID = types.StringValue(name)
+	state.Users = mergeUserlistUsers(state.Users, users)
+	state.Groups = mergeUserlistGroups(state.Groups, groups)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Update reconciles users and groups by name in a single transaction.
+func (r *userlistResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state, config userlistResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := plan.Name.ValueString()
+	passwords := writeOnlyPasswords(config.Users)
+	existingUsers := make(map[string]haproxyUserlistUserModel, len(state.Users))
+	for _, user := range state.Users {
+		existingUsers[user.Username.ValueString()] = user
+	}
+	desiredUsers := make(map[string]bool, len(plan.Users))
+	for _, user := range plan.Users {
+		desiredUsers[user.Username.ValueString()] = true
+	}
+	existingGroups := make(map[string]haproxyUserlistGroupModel, len(state.Groups))
+	for _, group := range state.Groups {
+		existingGroups[group.Name.ValueString()] = group
+	}
+	desiredGroups := make(map[string]bool, len(plan.Groups))
+	for _, group := range plan.Groups {
+		desiredGroups[group.Name.ValueString()] = true
+	}
+
+	err := r.client.InTransaction(func(transactionID string) error {
+		for groupName := range existingGroups {
+			if !desiredGroups[groupName] {
+				if err := r.client.DeleteGroupInTransaction(ctx, transactionID, name, groupName); err != nil {
+					return fmt.Errorf("group %s: %w", groupName, err)
+				}
+			}
+		}
+		for username := range existingUsers {
+			if !desiredUsers[username] {
+				if err := r.client.DeleteUserInTransaction(ctx, transactionID, name, username); err != nil {
+					return fmt.Errorf("user %s: %w", username, err)
+				}
+			}
+		}
+		for _, user := range plan.Users {
+			payload := userToPayload(user, passwords)
+			current, exists := existingUsers[payload.Username]
+			switch {
+			case !exists:
+				if err := r.client.CreateUserInTransaction(ctx, transactionID, name, payload); err != nil {
+					return fmt.Errorf("user %s: %w", payload.Username, err)
+				}
+			case !current.PasswordHash.Equal(user.PasswordHash) || !current.PasswordWOVersion.Equal(user.PasswordWOVersion):
+				if err := r.client.UpdateUserInTransaction(ctx, transactionID, name, payload); err != nil {
+					return fmt.Errorf("user %s: %w", payload.Username, err)
+				}
+			}
+		}
+		for _, group := range plan.Groups {
+			payload := groupToPayload(group)
+			current, exists := existingGroups[payload.Name]
+			switch {
+			case !exists:
+				if err := r.client.CreateGroupInTransaction(ctx, transactionID, name, payload); err != nil {
+					return fmt.Errorf("group %s: %w", payload.Name, err)
+				}
+			case groupToPayload(current).Users != payload.Users:
+				if err := r.client.UpdateGroupInTransaction(ctx, transactionID, name, payload); err != nil {
+					return fmt.Errorf("group %s: %w", payload.Name, err)
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating userlist", fmt.Sprintf("Could not update userlist %s: %s", name, err))
+		return
+	}
+
+	plan.ID = types.StringValue(name)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Delete deletes the userlist; its users and groups are removed with it.
+func (r *userlistResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state userlistResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := state.Name.ValueString()
+	err := r.client.InTransaction(func(transactionID string) error {
+		return r.client.DeleteUserlistInTransaction(ctx, transactionID, name)
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error deleting userlist", fmt.Sprintf("Could not delete userlist %s: %s", name, err))
+	}
+}
+
+// ImportState imports a userlist by name. Users with a plain-text password are imported
+// without password_wo, which can only be supplied by the configuration.
+func (r *userlistResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), req.ID)...)
+}
+
+// writeOnlyPasswords returns the password_wo values of the configuration by username; write-only
+// values are never present in the plan or state.
+func writeOnlyPasswords(users []haproxyUserlistUserModel) map[string]string {
+	passwords := make(map[string]string, len(users))
+	for _, user := range users {
+		passwords[user.Username.ValueString()] = user.PasswordWO.ValueString()
+	}
+	return passwords
+}
+
+// userToPayload converts a user block to a UserPayload; a password_hash takes precedence
+// over the write-only password.
+func userToPayload(user haproxyUserlistUserModel, passwords map[string]string) *UserPayload {
+	payload := &UserPayload{Username: user.Username.ValueString()}
+	if !user.PasswordHash.IsNull() {
+		payload.Password = user.PasswordHash.ValueString()
+		payload.SecurePassword = true
+	} else {
+		payload.Password = passwords[payload.Username]
+	}
+	return payload
+}
+
+// groupToPayload converts a group block to a GroupPayload.
+func groupToPayload(group haproxyUserlistGroupModel) *GroupPayload {
+	users := make([]string, 0, len(group.Users))
+	for _, user := range group.Users {
+		users = append(users, user.ValueString())
+	}
+	return &GroupPayload{
+		Name:  group.Name.ValueString(),
+		Users: strings.Join(users, ","),
+	}
+}
+
+// mergeUserlistUsers returns the users read from HAProxy in the order of the current state.
+// Plain-text passwords are never read back; password_wo_version is kept from the state.
+func mergeUserlistUsers(current []haproxyUserlistUserModel, read []UserPayload) []haproxyUserlistUserModel {
+	byName := make(map[string]UserPayload, len(read))
+	for _, user := range read {
+		byName[user.Username] = user
+	}
+
+	toModel := func(payload UserPayload, version types.Int64) haproxyUserlistUserModel {
+		model := haproxyUserlistUserModel{
+			Username:          types.StringValue(payload.Username),
+			PasswordWO:        types.StringNull(),
+			PasswordWOVersion: version,
+			PasswordHash:      types.StringNull(),
+		}
+		if payload.SecurePassword {
+			model.PasswordHash = types.StringValue(payload.Password)
+		}
+		return model
+	}
+
+	var result []haproxyUserlistUserModel
+	seen := make(map[string]bool, len(read))
+	for _, user := range current {
+		if payload, ok := byName[user.Username.ValueString()]; ok {
+			seen[payload.Username] = true
+			result = append(result, toModel(payload, user.PasswordWOVersion))
+		}
+	}
+	for _, payload := range read {
+		if !seen[payload.Username] {
+			result = append(result, toModel(payload, types.Int64Null()))
+		}
+	}
+	return result
+}
+
+// mergeUserlistGroups returns the groups read from HAProxy in the order of the current state.
+func mergeUserlistGroups(current []haproxyUserlistGroupModel, read []GroupPayload) []haproxyUserlistGroupModel {
+	byName := make(map[string]GroupPayload, len(read))
+	for _, group := range read {
+		byName[group.Name] = group
+	}
+
+	toModel := func(payload GroupPayload) haproxyUserlistGroupModel {
+		model := haproxyUserlistGroupModel{Name: types.StringValue(payload.Name)}
+		for _, user := range strings.Split(payload.Users, ",") {
+			if user = strings.TrimSpace(user); user != "" {
+				model.Users = append(model.Users, types.StringValue(user))
+			}
+		}
+		return model
+	}
+
+	var result []haproxyUserlistGroupModel
+	seen := make(map[string]bool, len(read))
+	for _, group := range current {
+		if payload, ok := byName[group.Name.ValueString()]; ok {
+			seen[payload.Name] = true
+			result = append(result, toModel(payload))
+		}
+	}
+	for _, payload := range read {
+		if !seen[payload.Name] {
+			result = append(result, toModel(payload))
+		}
+	}
+	return result
+}
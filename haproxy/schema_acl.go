@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"reflect"
 	"sort"
 	"strings"
 
@@ -27,11 +28,11 @@ func GetACLSchema() schema.ListNestedBlock {
 				},
 				"criterion": schema.StringAttribute{
 					Required:    true,
-					Description: "The criterion for the ACL rule (e.g., 'path', 'hdr', 'src').",
+					Description: "The criterion for the ACL rule (e.g., 'path', 'hdr', 'src', 'http_auth(<userlist>)').",
 				},
 				"value": schema.StringAttribute{
-					Required:    true,
-					Description: "The value for the ACL rule.",
+					Optional:    true,
+					Description: "The value for the ACL rule. Omitted for criteria that match on their own, such as http_auth(<userlist>).",
 				},
 				"index": schema.Int64Attribute{
 					Optional:    true,
@@ -149,13 +150,14 @@ func (r *ACLManager) UpdateACLs(ctx context.Context, parentType, parentName stri
 	return r.updateAclsWithIndexing(ctx, parentType, parentName, existingAcls, newAcls)
 }
 
-// UpdateACLsInTransaction updates ACLs using an existing transaction ID with smart comparison
-func (r *ACLManager) UpdateACLsInTransaction(ctx context.Context, transactionID, parentType, parentName string, acls []haproxyAclModel) error {
-	return r.updateAclsWithIndexingInTransaction(ctx, transactionID, parentType, parentName, acls)
+// UpdateACLsInTransaction updates ACLs using an existing transaction ID with smart comparison.
+// Only the ACLs matching managedAcls (the ACLs the caller created before) are replaced.
+func (r *ACLManager) UpdateACLsInTransaction(ctx context.Context, transactionID, parentType, parentName string, acls, managedAcls []haproxyAclModel) error {
+	return r.updateAclsWithIndexingInTransaction(ctx, transactionID, parentType, parentName, acls, managedAcls)
 }
 
 // updateAclsWithIndexingInTransaction performs smart ACL updates by comparing existing vs desired
-func (r *ACLManager) updateAclsWithIndexingInTransaction(ctx context.Context, transactionID, parentType, parentName string, desiredAcls []haproxyAclModel) error {
+func (r *ACLManager) updateAclsWithIndexingInTransaction(ctx context.Context, transactionID, parentType, parentName string, desiredAcls, managedAcls []haproxyAclModel) error {
 	// Read existing ACLs to compare with desired ones
 	existingAcls, err := r.client.ReadACLs(ctx, parentType, parentName)
 	if err != nil {
@@ -163,132 +165,58 @@ func (r *ACLManager) updateAclsWithIndexingInTransaction(ctx context.Context, tr
 	}
 
 	// Use the smart comparison logic to only update what changed
-	return r.updateAclsWithIndexingInTransactionSmart(ctx, transactionID, parentType, parentName, existingAcls, desiredAcls)
+	return r.updateAclsWithIndexingInTransactionSmart(ctx, transactionID, parentType, parentName, existingAcls, desiredAcls, managedAcls)
 }
 
-// updateAclsWithIndexingInTransactionSmart performs smart ACL updates by comparing existing vs desired
-func (r *ACLManager) updateAclsWithIndexingInTransactionSmart(ctx context.Context, transactionID, parentType, parentName string, existingAcls []ACLPayload, desiredAcls []haproxyAclModel) error {
-	// Process desired ACLs with proper indexing and deduplication
-	sortedDesiredAcls := r.processAclsBlock(desiredAcls)
-
-	// Create a map of existing ACLs by name for quick lookup (since HAProxy API v3 returns all with index 0)
-	existingAclMap := make(map[string]*ACLPayload)
-	for i := range existingAcls {
-		existingAclMap[existingAcls[i].AclName] = &existingAcls[i]
-	}
-
-	// Check if any ACLs actually changed (content OR order)
-	hasChanges := false
-	var aclsToRecreate []haproxyAclModel
-
-	log.Printf("DEBUG: Comparing %d existing ACLs with %d desired ACLs for %s %s", len(existingAcls), len(sortedDesiredAcls), parentType, parentName)
-
-	// First, check if the number of ACLs changed
-	if len(existingAcls) != len(sortedDesiredAcls) {
-		log.Printf("DEBUG: ACL count changed from %d to %d, marking for recreation", len(existingAcls), len(sortedDesiredAcls))
-		hasChanges = true
-		aclsToRecreate = sortedDesiredAcls
-	} else {
-		// Check if ACLs have changed content OR order
-		for i, desiredAcl := range sortedDesiredAcls {
-			desiredName := desiredAcl.AclName.ValueString()
-			existingAcl, exists := existingAclMap[desiredName]
-
-			log.Printf("DEBUG: ACL %d - desired: name='%s', criterion='%s', value='%s'", i, desiredName, desiredAcl.Criterion.ValueString(), desiredAcl.Value.ValueString())
-
-			if !exists {
-				log.Printf("DEBUG: ACL %d - no existing ACL with name '%s', marking for recreation", i, desiredName)
-				hasChanges = true
-				aclsToRecreate = sortedDesiredAcls
-				break
-			} else {
-				log.Printf("DEBUG: ACL %d - existing: name='%s', criterion='%s', value='%s'", i, existingAcl.AclName, existingAcl.Criterion, existingAcl.Value)
-				changed := r.hasAclChanged(existingAcl, &desiredAcl)
-				log.Printf("DEBUG: ACL %d - hasAclChanged returned: %t", i, changed)
-				if changed {
-					log.Printf("DEBUG: ACL %d - marked for recreation due to content changes", i)
-					hasChanges = true
-					aclsToRecreate = sortedDesiredAcls
-					break
-				}
-			}
-		}
-
-		// If no content changes detected, check for order changes by comparing the sequence
-		if !hasChanges {
-			log.Printf("DEBUG: No content changes detected, checking for order changes...")
-			for i, desiredAcl := range sortedDesiredAcls {
-				desiredName := desiredAcl.AclName.ValueString()
-				// Check if the ACL at position i has the same name as the existing ACL at position i
-				if i < len(existingAcls) {
-					existingName := existingAcls[i].AclName
-					if desiredName != existingName {
-						log.Printf("DEBUG: Order change detected at position %d - desired: '%s', existing: '%s'", i, desiredName, existingName)
-						hasChanges = true
-						aclsToRecreate = sortedDesiredAcls
-						break
-					}
-				}
-			}
-		}
-	}
-
-	// Also check if any existing ACLs need to be removed (not in desired list)
-	if !hasChanges {
-		for existingName := range existingAclMap {
-			found := false
-			for _, desiredAcl := range sortedDesiredAcls {
-				if desiredAcl.AclName.ValueString() == existingName {
-					found = true
-					break
-				}
-			}
-			if !found {
-				log.Printf("DEBUG: Existing ACL '%s' not in desired list, marking for removal", existingName)
-				hasChanges = true
-				aclsToRecreate = sortedDesiredAcls
-				break
-			}
-		}
-	}
-
-	log.Printf("DEBUG: Final hasChanges result: %t, ACLs to recreate: %d", hasChanges, len(aclsToRecreate))
+// updateAclsWithIndexingInTransactionSmart replaces the ACLs matching managedAcls with desiredAcls,
+// keeping the ACLs created outside the caller (e.g. haproxy_acl resources) in place
+func (r *ACLManager) updateAclsWithIndexingInTransactionSmart(ctx context.Context, transactionID, parentType, parentName string, existingAcls []ACLPayload, desiredAcls, managedAcls []haproxyAclModel) error {
+	desired := r.convertToAclPayloads(desiredAcls)
+	managed := r.convertToAclPayloads(managedAcls)
+	merged := mergeManagedItems(existingAcls, managed, desired, func(existing, managed ACLPayload) bool {
+		return existing.AclName == managed.AclName && existing.Criterion == managed.Criterion && existing.Value == managed.Value
+	})
 
-	// If no changes detected, skip the update
-	if !hasChanges {
+	unchanged := itemsEqual(existingAcls, merged, func(a, b ACLPayload) bool {
+		a.Index, b.Index = 0, 0
+		return reflect.DeepEqual(a, b)
+	})
+	if unchanged {
 		log.Printf("No ACL changes detected for %s %s, skipping update", parentType, parentName)
 		return nil
 	}
 
+	for i := range merged {
+		merged[i].Index = int64(i)
+	}
+
 	// First, delete all existing ACLs to avoid duplicates
 	if err := r.DeleteACLsInTransaction(ctx, transactionID, parentType, parentName); err != nil {
 		return fmt.Errorf("failed to delete existing ACLs for %s %s: %w", parentType, parentName, err)
 	}
 
-	// Then create all desired ACLs using the same "create all at once" approach for both v2 and v3
-	// This ensures consistent formatting from HAProxy API
-	// Process ACLs with proper indexing and deduplication
-	sortedRules := r.processAclsBlock(desiredAcls)
+	// Then recreate the merged list in one request (same for both v2 and v3)
+	if err := r.client.CreateAllACLsInTransaction(ctx, transactionID, parentType, parentName, merged); err != nil {
+		return fmt.Errorf("failed to create new ACLs for %s %s: %w", parentType, parentName, err)
+	}
 
-	// Convert all ACLs to payloads
-	var allPayloads []ACLPayload
-	for i, acl := range sortedRules {
-		aclPayload := ACLPayload{
+	log.Printf("Updated %d ACLs (%d managed) for %s %s in transaction %s", len(merged), len(desired), parentType, parentName, transactionID)
+	return nil
+}
+
+// convertToAclPayloads converts the ACLs of a block to payloads in processing order
+func (r *ACLManager) convertToAclPayloads(acls []haproxyAclModel) []ACLPayload {
+	sortedAcls := r.processAclsBlock(acls)
+	payloads := make([]ACLPayload, 0, len(sortedAcls))
+	for i, acl := range sortedAcls {
+		payloads = append(payloads, ACLPayload{
 			AclName:   acl.AclName.ValueString(),
 			Criterion: acl.Criterion.ValueString(),
 			Value:     acl.Value.ValueString(),
-			Index:     int64(i), // Use array position instead of index field
-		}
-		allPayloads = append(allPayloads, aclPayload)
-	}
-
-	// Send all ACLs in one request (same for both v2 and v3)
-	if err := r.client.CreateAllACLsInTransaction(ctx, transactionID, parentType, parentName, allPayloads); err != nil {
-		return fmt.Errorf("failed to create new ACLs for %s %s: %w", parentType, parentName, err)
+			Index:     int64(i),
+		})
 	}
-
-	log.Printf("Updated %d ACLs for %s %s in transaction %s (delete-then-create)", len(allPayloads), parentType, parentName, transactionID)
-	return nil
+	return payloads
 }
 
 // DeleteACLsInTransaction deletes all ACLs for a given parent using an existing transaction ID
@@ -1,7 +1,11 @@
 package haproxy
 
 import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 )
 
 // GetBackendSchema returns the schema for the backend block
@@ -57,6 +61,10 @@ func GetBackendSchema() schema.SingleNestedBlock {
 				Optional:    true,
 				Description: "Number of retries for failed operations.",
 			},
+			"defaults": schema.StringAttribute{
+				Optional:    true,
+				Description: "The name of the defaults section the backend inherits from.",
+			},
 			"servers": GetServersSchema(),
 		},
 		Blocks: map[string]schema.Block{
@@ -212,10 +220,16 @@ func GetBackendSchema() schema.SingleNestedBlock {
 					},
 				},
 			},
-			"http_request_rules":  GetHttpRequestRuleSchema(),
-			"http_response_rules": GetHttpResponseRuleSchema(),
-			"tcp_request_rules":   GetTcpRequestRuleSchema(),
-			"tcp_response_rules":  GetTcpResponseRuleSchema(),
+			"http_request_rules":        GetHttpRequestRuleSchema(),
+			"http_response_rules":       GetHttpResponseRuleSchema(),
+			"http_after_response_rules": GetHttpAfterResponseRuleSchema(),
+			"tcp_request_rules":         GetTcpRequestRuleSchema(),
+			"tcp_response_rules":        GetTcpResponseRuleSchema(),
+			"stick_rules":               GetStickRuleSchema(),
+			"server_switching_rules":    GetServerSwitchingRuleSchema(),
+			"compression":               GetCompressionSchema(),
+			"filters":                   GetFilterSchema(),
+			"error_files":               GetErrorFilesSchema(),
 			"stick_table": schema.SingleNestedBlock{
 				Description: "Stick table configuration for the backend.",
 				Attributes: map[string]schema.Attribute{
@@ -223,13 +237,13 @@ func GetBackendSchema() schema.SingleNestedBlock {
 						Optional:    true,
 						Description: "The type of the stick table.",
 					},
-					"size": schema.StringAttribute{
+					"size": schema.Int64Attribute{
 						Optional:    true,
 						Description: "The size of the stick table.",
 					},
-					"expire": schema.StringAttribute{
+					"expire": schema.Int64Attribute{
 						Optional:    true,
-						Description: "The expiration time for the stick table.",
+						Description: "The expiration time for the stick table in milliseconds.",
 					},
 					"nopurge": schema.BoolAttribute{
 						Optional:    true,
@@ -237,7 +251,44 @@ func GetBackendSchema() schema.SingleNestedBlock {
 					},
 					"peers": schema.StringAttribute{
 						Optional:    true,
-						Description: "The peers for the stick table.",
+						Description: "The name of the peers section (see haproxy_peers) the stick table is replicated to.",
+					},
+				},
+			},
+			"email_alert": schema.SingleNestedBlock{
+				Description: "Email alerts sent when servers of the backend change state.",
+				Attributes: map[string]schema.Attribute{
+					"from": schema.StringAttribute{
+						Optional:    true,
+						Description: "The sender address of the alerts.",
+					},
+					"to": schema.StringAttribute{
+						Optional:    true,
+						Description: "The recipient address of the alerts.",
+					},
+					"level": schema.StringAttribute{
+						Optional:    true,
+						Description: "The maximum log level of messages that trigger an alert (e.g. alert, notice, info).",
+					},
+					"mailers": schema.StringAttribute{
+						Optional:    true,
+						Description: "The name of the mailers section (see haproxy_mailers) used to send the alerts.",
+					},
+					"myhostname": schema.StringAttribute{
+						Optional:    true,
+						Description: "The hostname announced to the SMTP servers.",
+					},
+				},
+			},
+			"drain_before_delete": schema.SingleNestedBlock{
+				Description: "Drain servers removed from servers through the runtime API and wait for their sessions to end before deleting them.",
+				Attributes: map[string]schema.Attribute{
+					"timeout": schema.Int64Attribute{
+						Optional:    true,
+						Description: fmt.Sprintf("The maximum time to wait for the sessions of drained servers to end, in seconds. The servers are deleted when it expires. Defaults to %d.", defaultDrainTimeout),
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
 					},
 				},
 			},
@@ -0,0 +1,158 @@
+package haproxy
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// GetBackendSwitchingRuleSchema returns the schema for the backend_switching_rules block
+func GetBackendSwitchingRuleSchema() schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		Description: "Backend switching rules (use_backend). The first rule whose condition matches selects the backend; default_backend is used when none matches. Rules are applied in the order they are declared.",
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"name": schema.StringAttribute{
+					Required:    true,
+					Description: "The name of the backend to use. It may contain a log-format expression (e.g. %[req.hdr(host),lower,map(/etc/haproxy/maps/hosts.map)]).",
+				},
+				"cond": schema.StringAttribute{
+					Optional:    true,
+					Description: "The condition of the rule (if, unless).",
+					Validators: []validator.String{
+						stringvalidator.OneOf("if", "unless"),
+					},
+				},
+				"cond_test": schema.StringAttribute{
+					Optional:    true,
+					Description: "The condition test of the rule, usually built from the frontend ACLs (e.g. is_api).",
+				},
+			},
+		},
+	}
+}
+
+// validate checks that the condition and its test are set together.
+func (r haproxyBackendSwitchingRuleModel) validate(diags *diag.Diagnostics, rulePath path.Path) {
+	hasCond := !r.Cond.IsNull() && r.Cond.ValueString() != ""
+	hasCondTest := !r.CondTest.IsNull() && r.CondTest.ValueString() != ""
+	if hasCond && !hasCondTest && !r.CondTest.IsUnknown() {
+		diags.AddAttributeError(rulePath.AtName("cond_test"), "Missing cond_test",
+			"cond_test is required when cond is set.")
+	}
+	if hasCondTest && !hasCond && !r.Cond.IsUnknown() {
+		diags.AddAttributeError(rulePath.AtName("cond"), "Missing cond",
+			"cond (if or unless) is required when cond_test is set.")
+	}
+}
+
+// BackendSwitchingRuleManager manages the backend switching rules of a frontend
+type BackendSwitchingRuleManager struct {
+	client *HAProxyClient
+}
+
+// CreateBackendSwitchingRuleManager creates a new backend switching rule manager
+func CreateBackendSwitchingRuleManager(client *HAProxyClient) *BackendSwitchingRuleManager {
+	return &BackendSwitchingRuleManager{
+		client: client,
+	}
+}
+
+// Create creates backend switching rules
+func (r *BackendSwitchingRuleManager) Create(ctx context.Context, transactionID, frontend string, rules []haproxyBackendSwitchingRuleModel) error {
+	if len(rules) == 0 {
+		return nil
+	}
+
+	log.Printf("Creating %d backend switching rules for frontend %s", len(rules), frontend)
+
+	if err := r.client.CreateAllBackendSwitchingRulesInTransaction(ctx, transactionID, frontend, r.convertToBackendSwitchingRulePayloads(rules)); err != nil {
+		return fmt.Errorf("failed to create backend switching rules for frontend %s: %w", frontend, err)
+	}
+
+	return nil
+}
+
+// Read reads backend switching rules
+func (r *BackendSwitchingRuleManager) Read(ctx context.Context, frontend string) ([]haproxyBackendSwitchingRuleModel, error) {
+	payloads, err := r.client.ReadBackendSwitchingRules(ctx, frontend)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read backend switching rules for frontend %s: %w", frontend, err)
+	}
+
+	sort.Slice(payloads, func(i, j int) bool {
+		return payloads[i].Index < payloads[j].Index
+	})
+
+	rules := make([]haproxyBackendSwitchingRuleModel, 0, len(payloads))
+	for _, payload := range payloads {
+		rules = append(rules, r.convertFromBackendSwitchingRulePayload(payload))
+	}
+	return rules, nil
+}
+
+// Update replaces the backend switching rules of a frontend
+func (r *BackendSwitchingRuleManager) Update(ctx context.Context, transactionID, frontend string, rules []haproxyBackendSwitchingRuleModel) error {
+	return r.client.replaceAllInTransaction(
+		func() error { return r.Delete(ctx, transactionID, frontend) },
+		func() error {
+			if err := r.client.CreateAllBackendSwitchingRulesInTransaction(ctx, transactionID, frontend, r.convertToBackendSwitchingRulePayloads(rules)); err != nil {
+				return fmt.Errorf("failed to replace backend switching rules for frontend %s: %w", frontend, err)
+			}
+			return nil
+		},
+	)
+}
+
+// Delete deletes all backend switching rules of a frontend
+func (r *BackendSwitchingRuleManager) Delete(ctx context.Context, transactionID, frontend string) error {
+	existingRules, err := r.client.ReadBackendSwitchingRules(ctx, frontend)
+	if err != nil {
+		return fmt.Errorf("failed to read existing backend switching rules for deletion: %w", err)
+	}
+
+	// Delete in reverse order (highest index first) to avoid shifting issues
+	sort.Slice(existingRules, func(i, j int) bool {
+		return existingRules[i].Index > existingRules[j].Index
+	})
+
+	for _, rule := range existingRules {
+		if err := r.client.DeleteBackendSwitchingRuleInTransaction(ctx, transactionID, rule.Index, frontend); err != nil {
+			return fmt.Errorf("failed to delete backend switching rule at index %d: %w", rule.Index, err)
+		}
+	}
+
+	log.Printf("Deleted %d backend switching rules for frontend %s", len(existingRules), frontend)
+	return nil
+}
+
+// convertToBackendSwitchingRulePayloads converts backend switching rule blocks to payloads, indexed by position
+func (r *BackendSwitchingRuleManager) convertToBackendSwitchingRulePayloads(rules []haproxyBackendSwitchingRuleModel) []BackendSwitchingRulePayload {
+	payloads := make([]BackendSwitchingRulePayload, 0, len(rules))
+	for i, rule := range rules {
+		payloads = append(payloads, BackendSwitchingRulePayload{
+			Index:    int64(i),
+			Name:     rule.Name.ValueString(),
+			Cond:     rule.Cond.ValueString(),
+			CondTest: rule.CondTest.ValueString(),
+		})
+	}
+	return payloads
+}
+
+// convertFromBackendSwitchingRulePayload converts a payload to a backend switching rule block
+func (r *BackendSwitchingRuleManager) convertFromBackendSwitchingRulePayload(payload BackendSwitchingRulePayload) haproxyBackendSwitchingRuleModel {
+	return haproxyBackendSwitchingRuleModel{
+		Name:     types.StringValue(payload.Name),
+		Cond:     stringOrNull(payload.Cond),
+		CondTest: stringOrNull(payload.CondTest),
+	}
+}
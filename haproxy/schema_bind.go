@@ -50,11 +50,11 @@ func GetBindSchema() schema.MapNestedAttribute {
 				},
 				"ssl_cafile": schema.StringAttribute{
 					Optional:    true,
-					Description: "SSL CA file for the bind.",
+					Description: "SSL CA file for the bind: a path on the HAProxy host, such as the file of an haproxy_ssl_certificate.",
 				},
 				"ssl_certificate": schema.StringAttribute{
 					Optional:    true,
-					Description: "SSL certificate for the bind.",
+					Description: "SSL certificate for the bind: a path on the HAProxy host, such as the file of an haproxy_ssl_certificate.",
 				},
 				"ssl_max_ver": schema.StringAttribute{
 					Optional:    true,
@@ -488,19 +488,25 @@ func (r *BindManager) DeleteBinds(ctx context.Context, parentType, parentName st
 	return r.deleteAllBinds(ctx, parentType, parentName)
 }
 
-// UpdateBindsInTransaction updates binds using an existing transaction ID
-func (r *BindManager) UpdateBindsInTransaction(ctx context.Context, transactionID, parentType, parentName string, binds map[string]haproxyBindModel) error {
-	if len(binds) == 0 {
-		// Delete all existing binds
-		return r.deleteAllBindsInTransaction(ctx, transactionID, parentType, parentName)
-	}
-
+// UpdateBindsInTransaction updates binds using an existing transaction ID.
+// Only binds listed in managedBinds (the prior state) or binds are considered, so binds
+// created outside the caller (e.g. by haproxy_bind resources) are left in place.
+func (r *BindManager) UpdateBindsInTransaction(ctx context.Context, transactionID, parentType, parentName string, binds, managedBinds map[string]haproxyBindModel) error {
 	// Read existing binds
-	existingBinds, err := r.ReadBinds(ctx, parentType, parentName)
+	allBinds, err := r.ReadBinds(ctx, parentType, parentName)
 	if err != nil {
 		return fmt.Errorf("failed to read existing binds: %w", err)
 	}
 
+	var existingBinds []BindPayload
+	for _, bind := range allBinds {
+		_, managed := managedBinds[bind.Name]
+		_, desired := binds[bind.Name]
+		if managed || desired {
+			existingBinds = append(existingBinds, bind)
+		}
+	}
+
 	// Use smart update logic with transaction support
 	return r.updateBindsWithHandlingInTransaction(ctx, transactionID, parentType, parentName, existingBinds, binds)
 }
@@ -771,6 +777,204 @@ func (r *BindManager) convertToBindPayload(bindName string, bind *haproxyBindMod
 	return payload
 }
 
+// convertFromBindPayload converts a BindPayload returned by HAProxy to haproxyBindModel.
+// Only fields HAProxy returned with a non-default value are set, everything else stays null.
+// TLS version fields (tlsv10-tlsv13) are left null, same as in the stack Read.
+func (r *BindManager) convertFromBindPayload(bind BindPayload) haproxyBindModel {
+	model := haproxyBindModel{
+		Address: types.StringValue(bind.Address),
+	}
+	if bind.Port != nil {
+		model.Port = types.Int64Value(*bind.Port)
+	}
+
+	if bind.Mode != "" {
+		model.Mode = types.StringValue(bind.Mode)
+	}
+	if bind.User != "" {
+		model.User = types.StringValue(bind.User)
+	}
+	if bind.Group != "" {
+		model.Group = types.StringValue(bind.Group)
+	}
+	if bind.SslCafile != "" {
+		model.SslCafile = types.StringValue(bind.SslCafile)
+	}
+	if bind.SslCertificate != "" {
+		model.SslCertificate = types.StringValue(bind.SslCertificate)
+	}
+	if bind.SslMaxVer != "" {
+		model.SslMaxVer = types.StringValue(bind.SslMaxVer)
+	}
+	if bind.SslMinVer != "" {
+		model.SslMinVer = types.StringValue(bind.SslMinVer)
+	}
+	if bind.Ciphers != "" {
+		model.Ciphers = types.StringValue(bind.Ciphers)
+	}
+	if bind.Ciphersuites != "" {
+		model.Ciphersuites = types.StringValue(bind.Ciphersuites)
+	}
+	if bind.Verify != "" {
+		model.Verify = types.StringValue(bind.Verify)
+	}
+	if bind.Alpn != "" {
+		model.Alpn = types.StringValue(bind.Alpn)
+	}
+	if bind.Backlog != "" {
+		model.Backlog = types.StringValue(bind.Backlog)
+	}
+	if bind.Id != "" {
+		model.Id = types.StringValue(bind.Id)
+	}
+	if bind.Interface != "" {
+		model.Interface = types.StringValue(bind.Interface)
+	}
+	if bind.Level != "" {
+		model.Level = types.StringValue(bind.Level)
+	}
+	if bind.Namespace != "" {
+		model.Namespace = types.StringValue(bind.Namespace)
+	}
+	if bind.Npn != "" {
+		model.Npn = types.StringValue(bind.Npn)
+	}
+	if bind.Process != "" {
+		model.Process = types.StringValue(bind.Process)
+	}
+	if bind.Proto != "" {
+		model.Proto = types.StringValue(bind.Proto)
+	}
+	if bind.SeverityOutput != "" {
+		model.SeverityOutput = types.StringValue(bind.SeverityOutput)
+	}
+	if bind.TlsTicketKeys != "" {
+		model.TlsTicketKeys = types.StringValue(bind.TlsTicketKeys)
+	}
+	if bind.Uid != "" {
+		model.Uid = types.StringValue(bind.Uid)
+	}
+	if bind.TlsTickets != "" {
+		model.TlsTickets = types.StringValue(bind.TlsTickets)
+	}
+	if bind.ForceStrictSni != "" {
+		model.ForceStrictSni = types.StringValue(bind.ForceStrictSni)
+	}
+	if bind.GuidPrefix != "" {
+		model.GuidPrefix = types.StringValue(bind.GuidPrefix)
+	}
+	if bind.QuicCcAlgo != "" {
+		model.QuicCcAlgo = types.StringValue(bind.QuicCcAlgo)
+	}
+	if bind.QuicSocket != "" {
+		model.QuicSocket = types.StringValue(bind.QuicSocket)
+	}
+	if bind.Maxconn != 0 {
+		model.Maxconn = types.Int64Value(bind.Maxconn)
+	}
+	if bind.Gid != 0 {
+		model.Gid = types.Int64Value(bind.Gid)
+	}
+	if bind.Nice != 0 {
+		model.Nice = types.Int64Value(bind.Nice)
+	}
+	if bind.TcpUserTimeout != 0 {
+		model.TcpUserTimeout = types.Int64Value(bind.TcpUserTimeout)
+	}
+	if bind.PortRangeEnd != nil {
+		model.PortRangeEnd = types.Int64Value(*bind.PortRangeEnd)
+	}
+	if bind.IdlePing != nil {
+		model.IdlePing = types.Int64Value(*bind.IdlePing)
+	}
+	if bind.QuicCcAlgoBurstSize != nil {
+		model.QuicCcAlgoBurstSize = types.Int64Value(*bind.QuicCcAlgoBurstSize)
+	}
+	if bind.QuicCcAlgoMaxWindow != nil {
+		model.QuicCcAlgoMaxWindow = types.Int64Value(*bind.QuicCcAlgoMaxWindow)
+	}
+	if bind.Transparent {
+		model.Transparent = types.BoolValue(true)
+	}
+	if bind.Ssl {
+		model.Ssl = types.BoolValue(true)
+	}
+	if bind.AcceptProxy {
+		model.AcceptProxy = types.BoolValue(true)
+	}
+	if bind.Allow0rtt {
+		model.Allow0rtt = types.BoolValue(true)
+	}
+	if bind.DeferAccept {
+		model.DeferAccept = types.BoolValue(true)
+	}
+	if bind.GenerateCertificates {
+		model.GenerateCertificates = types.BoolValue(true)
+	}
+	if bind.NoCaNames {
+		model.NoCaNames = types.BoolValue(true)
+	}
+	if bind.PreferClientCiphers {
+		model.PreferClientCiphers = types.BoolValue(true)
+	}
+	if bind.StrictSni {
+		model.StrictSni = types.BoolValue(true)
+	}
+	if bind.Tfo {
+		model.Tfo = types.BoolValue(true)
+	}
+	if bind.V4v6 {
+		model.V4v6 = types.BoolValue(true)
+	}
+	if bind.V6only {
+		model.V6only = types.BoolValue(true)
+	}
+	if bind.Sslv3 {
+		model.Sslv3 = types.BoolValue(true)
+	}
+	if bind.NoStrictSni {
+		model.NoStrictSni = types.BoolValue(true)
+	}
+	if bind.QuicForceRetry {
+		model.QuicForceRetry = types.BoolValue(true)
+	}
+	if bind.NoSslv3 {
+		model.NoSslv3 = types.BoolValue(true)
+	}
+	if bind.ForceSslv3 {
+		model.ForceSslv3 = types.BoolValue(true)
+	}
+	if bind.ForceTlsv10 {
+		model.ForceTlsv10 = types.BoolValue(true)
+	}
+	if bind.ForceTlsv11 {
+		model.ForceTlsv11 = types.BoolValue(true)
+	}
+	if bind.ForceTlsv12 {
+		model.ForceTlsv12 = types.BoolValue(true)
+	}
+	if bind.ForceTlsv13 {
+		model.ForceTlsv13 = types.BoolValue(true)
+	}
+	if bind.NoTlsv10 {
+		model.NoTlsv10 = types.BoolValue(true)
+	}
+	if bind.NoTlsv11 {
+		model.NoTlsv11 = types.BoolValue(true)
+	}
+	if bind.NoTlsv12 {
+		model.NoTlsv12 = types.BoolValue(true)
+	}
+	if bind.NoTlsv13 {
+		model.NoTlsv13 = types.BoolValue(true)
+	}
+	if bind.NoTlsTickets {
+		model.NoTlsTickets = types.BoolValue(true)
+	}
+
+	return model
+}
+
 func (r *BindManager) updateBindsWithHandlingInTransaction(ctx context.Context, transactionID string, parentType string, parentName string, existingBinds []BindPayload, newBinds map[string]haproxyBindModel) error {
 	// Create maps for efficient lookup
 	existingBindMap := make(map[string]*BindPayload)
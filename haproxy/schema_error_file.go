@@ -0,0 +1,192 @@
+package haproxy
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"path/filepath"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// errorPageCodes are the HTTP status codes HAProxy accepts for errorfile and errorfiles.
+var errorPageCodes = []int64{200, 400, 401, 403, 404, 405, 407, 408, 410, 413, 425, 429, 500, 501, 502, 503, 504}
+
+// GetErrorFilesSchema returns the schema for the error_files block
+func GetErrorFilesSchema() schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		Description: "Custom error pages. Each page either has its content stored through the Data Plane API storage (errorfile) or is taken from an http-errors section (errorfiles).",
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"code": schema.Int64Attribute{
+					Required:    true,
+					Description: "The HTTP status code the page is returned for (e.g. 503).",
+					Validators: []validator.Int64{
+						int64validator.OneOf(errorPageCodes...),
+					},
+				},
+				"content": schema.StringAttribute{
+					Optional:    true,
+					Description: "The raw HTTP response, headers included, stored as a file through the Data Plane API storage. Conflicts with http_errors.",
+				},
+				"http_errors": schema.StringAttribute{
+					Optional:    true,
+					Description: "The name of the http-errors section (see haproxy_http_errors) the page for code is taken from. Conflicts with content.",
+				},
+				"file": schema.StringAttribute{
+					Computed:    true,
+					Description: "The path of the stored page on the HAProxy host, set when content is used.",
+				},
+			},
+		},
+	}
+}
+
+// validate checks that exactly one of content and http_errors is set.
+func (m haproxyErrorFilesModel) validate(diags *diag.Diagnostics, blockPath path.Path) {
+	if m.Content.IsUnknown() || m.HttpErrors.IsUnknown() {
+		return
+	}
+	if m.Content.IsNull() == m.HttpErrors.IsNull() {
+		diags.AddAttributeError(
+			blockPath,
+			"Invalid error_files block",
+			fmt.Sprintf("Error page %d must set exactly one of content and http_errors.", m.Code.ValueInt64()),
+		)
+	}
+}
+
+// ErrorFilesManager manages the error pages of a frontend or backend
+type ErrorFilesManager struct {
+	client *HAProxyClient
+}
+
+// CreateErrorFilesManager creates a new error files manager
+func CreateErrorFilesManager(client *HAProxyClient) *ErrorFilesManager {
+	return &ErrorFilesManager{
+		client: client,
+	}
+}
+
+// Store writes the content of the error pages to the general storage and sets their file.
+// Pages whose code and content are unchanged from previous keep their file without being
+// uploaded again. Storage is not transactional, so this runs before the commit.
+func (m *ErrorFilesManager) Store(ctx context.Context, parentType, parentName string, errorFiles, previous []haproxyErrorFilesModel) error {
+	previousByCode := make(map[int64]haproxyErrorFilesModel, len(previous))
+	for _, errorFile := range previous {
+		previousByCode[errorFile.Code.ValueInt64()] = errorFile
+	}
+
+	for i := range errorFiles {
+		errorFile := &errorFiles[i]
+		code := errorFile.Code.ValueInt64()
+		if errorFile.Content.IsNull() {
+			errorFile.File = types.StringNull()
+			continue
+		}
+		if prev, ok := previousByCode[code]; ok && prev.Content.Equal(errorFile.Content) && !prev.File.IsNull() && !prev.File.IsUnknown() {
+			errorFile.File = prev.File
+			continue
+		}
+
+		storageName := fmt.Sprintf("%s_%s_%d.http", parentType, parentName, code)
+		file, err := m.client.WriteGeneralFile(ctx, storageName, []byte(errorFile.Content.ValueString()))
+		if err != nil {
+			return fmt.Errorf("failed to store error page %d of %s %s: %w", code, parentType, parentName, err)
+		}
+		errorFile.File = types.StringValue(file)
+	}
+	return nil
+}
+
+// Cleanup deletes the stored pages of previous that errorFiles no longer uses. It runs after
+// the commit, so failures are only logged.
+func (m *ErrorFilesManager) Cleanup(ctx context.Context, previous, errorFiles []haproxyErrorFilesModel) {
+	inUse := make(map[string]bool, len(errorFiles))
+	for _, errorFile := range errorFiles {
+		if !errorFile.File.IsNull() && !errorFile.File.IsUnknown() {
+			inUse[filepath.Base(errorFile.File.ValueString())] = true
+		}
+	}
+
+	for _, errorFile := range previous {
+		if errorFile.File.IsNull() || errorFile.File.IsUnknown() {
+			continue
+		}
+		storageName := filepath.Base(errorFile.File.ValueString())
+		if inUse[storageName] {
+			continue
+		}
+		if err := m.client.DeleteGeneralFile(ctx, storageName); err != nil {
+			log.Printf("Warning: Failed to delete stored error page %s: %v", storageName, err)
+		}
+	}
+}
+
+// processErrorFilesBlock converts error_files blocks to the errorfile and errorfiles payloads.
+// Pages taken from the same http-errors section are grouped into one errorfiles entry.
+func (m *ErrorFilesManager) processErrorFilesBlock(errorFiles []haproxyErrorFilesModel) ([]ErrorFilePayload, []ErrorFilesPayload) {
+	var files []ErrorFilePayload
+	var fromHttpErrors []ErrorFilesPayload
+	sections := make(map[string]int)
+	for _, errorFile := range errorFiles {
+		code := errorFile.Code.ValueInt64()
+		if !errorFile.Content.IsNull() {
+			files = append(files, ErrorFilePayload{Code: code, File: errorFile.File.ValueString()})
+			continue
+		}
+
+		name := errorFile.HttpErrors.ValueString()
+		if i, ok := sections[name]; ok {
+			fromHttpErrors[i].Codes = append(fromHttpErrors[i].Codes, code)
+			continue
+		}
+		sections[name] = len(fromHttpErrors)
+		fromHttpErrors = append(fromHttpErrors, ErrorFilesPayload{Name: name, Codes: []int64{code}})
+	}
+	return files, fromHttpErrors
+}
+
+// Read converts the error pages read from HAProxy to error_files blocks. The content of a
+// page is kept from existing while its file is unchanged, otherwise it is downloaded from
+// the general storage.
+func (m *ErrorFilesManager) Read(ctx context.Context, files []ErrorFilePayload, fromHttpErrors []ErrorFilesPayload, existing []haproxyErrorFilesModel) []haproxyErrorFilesModel {
+	existingByFile := make(map[string]haproxyErrorFilesModel, len(existing))
+	for _, errorFile := range existing {
+		existingByFile[errorFile.File.ValueString()] = errorFile
+	}
+
+	var errorFiles []haproxyErrorFilesModel
+	for _, file := range files {
+		model := haproxyErrorFilesModel{
+			Code:       types.Int64Value(file.Code),
+			Content:    types.StringNull(),
+			HttpErrors: types.StringNull(),
+			File:       types.StringValue(file.File),
+		}
+		if prev, ok := existingByFile[file.File]; ok && !prev.Content.IsNull() {
+			model.Content = prev.Content
+		} else if content, err := m.client.ReadGeneralFile(ctx, filepath.Base(file.File)); err != nil {
+			log.Printf("Warning: Failed to read stored error page %s: %v", file.File, err)
+		} else if content != nil {
+			model.Content = types.StringValue(string(content))
+		}
+		errorFiles = append(errorFiles, model)
+	}
+	for _, section := range fromHttpErrors {
+		for _, code := range section.Codes {
+			errorFiles = append(errorFiles, haproxyErrorFilesModel{
+				Code:       types.Int64Value(code),
+				Content:    types.StringNull(),
+				HttpErrors: types.StringValue(section.Name),
+				File:       types.StringNull(),
+			})
+		}
+	}
+	return errorFiles
+}
@@ -0,0 +1,356 @@
+package haproxy
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// GetCompressionSchema returns the schema for the compression block
+func GetCompressionSchema() schema.SingleNestedBlock {
+	return schema.SingleNestedBlock{
+		Description: "HTTP compression (compression algo, compression type and compression offload).",
+		Attributes: map[string]schema.Attribute{
+			"algorithms": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "The compression algorithms, in order of preference (identity, gzip, deflate, raw-deflate).",
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(stringvalidator.OneOf("identity", "gzip", "deflate", "raw-deflate")),
+				},
+			},
+			"types": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "The MIME types of the responses that are compressed (e.g. application/json, text/html).",
+			},
+			"offload": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether to remove the Accept-Encoding header of requests so that the servers do not compress responses.",
+			},
+		},
+	}
+}
+
+// GetFilterSchema returns the schema for the filters block
+func GetFilterSchema() schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		Description: "Filters (filter). Filters are chained in the order they are declared. When a filter other than compression is declared, compression must also be declared as a filter for the compression block to apply.",
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"type": schema.StringAttribute{
+					Required:    true,
+					Description: "The type of the filter (bwlim-in, bwlim-out, cache, compression, fcgi-app, spoe, trace).",
+					Validators: []validator.String{
+						stringvalidator.OneOf("bwlim-in", "bwlim-out", "cache", "compression", "fcgi-app", "spoe", "trace"),
+					},
+				},
+				"app_name": schema.StringAttribute{
+					Optional:    true,
+					Description: "The name of the fcgi-app section, for fcgi-app filters.",
+				},
+				"bandwidth_limit_name": schema.StringAttribute{
+					Optional:    true,
+					Description: "The name of the bandwidth limitation, referenced by the set-bandwidth-limit actions, for bwlim-in and bwlim-out filters.",
+				},
+				"cache_name": schema.StringAttribute{
+					Optional:    true,
+					Description: "The name of the cache section (see haproxy_cache), for cache filters.",
+				},
+				"default_limit": schema.Int64Attribute{
+					Optional:    true,
+					Description: "The default bandwidth limit in bytes per default_period, for per-stream bwlim-in and bwlim-out filters.",
+					Validators: []validator.Int64{
+						int64validator.AtLeast(1),
+					},
+				},
+				"default_period": schema.Int64Attribute{
+					Optional:    true,
+					Description: "The period of default_limit in milliseconds.",
+					Validators: []validator.Int64{
+						int64validator.AtLeast(1),
+					},
+				},
+				"key": schema.StringAttribute{
+					Optional:    true,
+					Description: "The sample expression used as the stick table key, for shared bwlim-in and bwlim-out filters (e.g. src).",
+				},
+				"limit": schema.Int64Attribute{
+					Optional:    true,
+					Description: "The shared bandwidth limit in bytes per period of the bytes rate stored in the stick table, for bwlim-in and bwlim-out filters.",
+					Validators: []validator.Int64{
+						int64validator.AtLeast(1),
+					},
+				},
+				"min_size": schema.Int64Attribute{
+					Optional:    true,
+					Description: "The minimum number of bytes forwarded at once, for bwlim-in and bwlim-out filters.",
+				},
+				"table": schema.StringAttribute{
+					Optional:    true,
+					Description: "The stick table storing the bytes rate, for shared bwlim-in and bwlim-out filters. Defaults to the table of the current proxy.",
+				},
+				"spoe_config": schema.StringAttribute{
+					Optional:    true,
+					Description: "The path of the SPOE configuration file, for spoe filters.",
+				},
+				"spoe_engine": schema.StringAttribute{
+					Optional:    true,
+					Description: "The SPOE engine of the configuration file to use, for spoe filters.",
+				},
+				"trace_name": schema.StringAttribute{
+					Optional:    true,
+					Description: "The name prefixed to the trace messages, for trace filters.",
+				},
+				"trace_hexdump": schema.BoolAttribute{
+					Optional:    true,
+					Description: "Whether to dump the forwarded data in hexadecimal, for trace filters.",
+				},
+				"trace_rnd_forwarding": schema.BoolAttribute{
+					Optional:    true,
+					Description: "Whether to forward a random amount of the available data, for trace filters.",
+				},
+				"trace_rnd_parsing": schema.BoolAttribute{
+					Optional:    true,
+					Description: "Whether to parse a random amount of the available data, for trace filters.",
+				},
+			},
+		},
+	}
+}
+
+// validate checks that the filter sets the arguments its type needs.
+func (m haproxyFilterModel) validate(diags *diag.Diagnostics, filterPath path.Path) {
+	requireString := func(value types.String, name string) {
+		if value.IsNull() {
+			diags.AddAttributeError(filterPath.AtName(name), "Missing "+name,
+				fmt.Sprintf("%s is required for %s filters.", name, m.Type.ValueString()))
+		}
+	}
+
+	switch m.Type.ValueString() {
+	case "cache":
+		requireString(m.CacheName, "cache_name")
+	case "fcgi-app":
+		requireString(m.AppName, "app_name")
+	case "spoe":
+		requireString(m.SpoeConfig, "spoe_config")
+	case "bwlim-in", "bwlim-out":
+		requireString(m.BandwidthLimitName, "bandwidth_limit_name")
+		// A bandwidth limitation is either per stream (default_limit) or shared through a stick table (limit)
+		if m.DefaultLimit.IsNull() == m.Limit.IsNull() && !m.DefaultLimit.IsUnknown() && !m.Limit.IsUnknown() {
+			diags.AddAttributeError(filterPath, "Invalid bandwidth limitation",
+				"Exactly one of default_limit (per stream) and limit (shared) must be set.")
+		}
+		if !m.DefaultLimit.IsNull() {
+			if m.DefaultPeriod.IsNull() {
+				diags.AddAttributeError(filterPath.AtName("default_period"), "Missing default_period",
+					"default_period is required when default_limit is set.")
+			}
+			if !m.Key.IsNull() || !m.Table.IsNull() {
+				diags.AddAttributeError(filterPath.AtName("default_limit"), "Conflicting bandwidth limitation",
+					"key and table are only used with limit.")
+			}
+		}
+		if !m.Limit.IsNull() {
+			requireString(m.Key, "key")
+			if !m.DefaultPeriod.IsNull() {
+				diags.AddAttributeError(filterPath.AtName("default_period"), "Conflicting bandwidth limitation",
+					"default_period is only used with default_limit.")
+			}
+		}
+	}
+}
+
+// validateFilters validates the filters of a frontend or backend, and checks that compression
+// is declared as a filter when the compression block is combined with other filters.
+func validateFilters(diags *diag.Diagnostics, filters []haproxyFilterModel, compression *haproxyCompressionModel, blockPath path.Path) {
+	for i, filter := range filters {
+		filter.validate(diags, blockPath.AtName("filters").AtListIndex(i))
+	}
+
+	if compression == nil || len(filters) == 0 {
+		return
+	}
+	hasCompressionFilter := slices.ContainsFunc(filters, func(filter haproxyFilterModel) bool {
+		return filter.Type.IsUnknown() || filter.Type.ValueString() == "compression"
+	})
+	if !hasCompressionFilter {
+		diags.AddAttributeError(blockPath.AtName("filters"), "Missing compression filter",
+			"HAProxy ignores the compression block when other filters are declared and compression is not; add a filters block with type = \"compression\".")
+	}
+}
+
+// processCompressionBlock converts the compression block to its payload
+func processCompressionBlock(compression *haproxyCompressionModel) *CompressionPayload {
+	if compression == nil {
+		return nil
+	}
+	payload := &CompressionPayload{
+		Offload: compression.Offload.ValueBool(),
+	}
+	for _, algorithm := range compression.Algorithms {
+		payload.Algorithms = append(payload.Algorithms, algorithm.ValueString())
+	}
+	for _, compressionType := range compression.Types {
+		payload.Types = append(payload.Types, compressionType.ValueString())
+	}
+	return payload
+}
+
+// convertCompressionFromPayload converts a compression payload to the compression block
+func convertCompressionFromPayload(compression *CompressionPayload) *haproxyCompressionModel {
+	if compression == nil || (len(compression.Algorithms) == 0 && len(compression.Types) == 0 && !compression.Offload) {
+		return nil
+	}
+	model := &haproxyCompressionModel{
+		Offload: boolOrNull(compression.Offload),
+	}
+	for _, algorithm := range compression.Algorithms {
+		model.Algorithms = append(model.Algorithms, types.StringValue(algorithm))
+	}
+	for _, compressionType := range compression.Types {
+		model.Types = append(model.Types, types.StringValue(compressionType))
+	}
+	return model
+}
+
+// FilterManager manages the filters of a frontend or backend
+type FilterManager struct {
+	client *HAProxyClient
+}
+
+// CreateFilterManager creates a new filter manager
+func CreateFilterManager(client *HAProxyClient) *FilterManager {
+	return &FilterManager{
+		client: client,
+	}
+}
+
+// Create creates filters
+func (r *FilterManager) Create(ctx context.Context, transactionID, parentType, parentName string, filters []haproxyFilterModel) error {
+	if len(filters) == 0 {
+		return nil
+	}
+
+	log.Printf("Creating %d filters for %s %s", len(filters), parentType, parentName)
+
+	if err := r.client.CreateAllFiltersInTransaction(ctx, transactionID, parentType, parentName, r.convertToFilterPayloads(filters)); err != nil {
+		return fmt.Errorf("failed to create filters for %s %s: %w", parentType, parentName, err)
+	}
+
+	return nil
+}
+
+// Read reads filters
+func (r *FilterManager) Read(ctx context.Context, parentType, parentName string) ([]haproxyFilterModel, error) {
+	payloads, err := r.client.ReadFilters(ctx, parentType, parentName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read filters for %s %s: %w", parentType, parentName, err)
+	}
+
+	sort.Slice(payloads, func(i, j int) bool {
+		return payloads[i].Index < payloads[j].Index
+	})
+
+	filters := make([]haproxyFilterModel, 0, len(payloads))
+	for _, payload := range payloads {
+		filters = append(filters, r.convertFromFilterPayload(payload))
+	}
+	return filters, nil
+}
+
+// Update replaces the filters of a parent
+func (r *FilterManager) Update(ctx context.Context, transactionID, parentType, parentName string, filters []haproxyFilterModel) error {
+	return r.client.replaceAllInTransaction(
+		func() error { return r.Delete(ctx, transactionID, parentType, parentName) },
+		func() error {
+			if err := r.client.CreateAllFiltersInTransaction(ctx, transactionID, parentType, parentName, r.convertToFilterPayloads(filters)); err != nil {
+				return fmt.Errorf("failed to replace filters for %s %s: %w", parentType, parentName, err)
+			}
+			return nil
+		},
+	)
+}
+
+// Delete deletes all filters of a parent
+func (r *FilterManager) Delete(ctx context.Context, transactionID, parentType, parentName string) error {
+	existingFilters, err := r.client.ReadFilters(ctx, parentType, parentName)
+	if err != nil {
+		return fmt.Errorf("failed to read existing filters for deletion: %w", err)
+	}
+
+	// Delete in reverse order (highest index first) to avoid shifting issues
+	sort.Slice(existingFilters, func(i, j int) bool {
+		return existingFilters[i].Index > existingFilters[j].Index
+	})
+
+	for _, filter := range existingFilters {
+		if err := r.client.DeleteFilterInTransaction(ctx, transactionID, filter.Index, parentType, parentName); err != nil {
+			return fmt.Errorf("failed to delete filter at index %d: %w", filter.Index, err)
+		}
+	}
+
+	log.Printf("Deleted %d filters for %s %s", len(existingFilters), parentType, parentName)
+	return nil
+}
+
+// convertToFilterPayloads converts filter blocks to payloads, indexed by position
+func (r *FilterManager) convertToFilterPayloads(filters []haproxyFilterModel) []FilterPayload {
+	payloads := make([]FilterPayload, 0, len(filters))
+	for i, filter := range filters {
+		payloads = append(payloads, FilterPayload{
+			Index:              int64(i),
+			Type:               filter.Type.ValueString(),
+			AppName:            filter.AppName.ValueString(),
+			BandwidthLimitName: filter.BandwidthLimitName.ValueString(),
+			CacheName:          filter.CacheName.ValueString(),
+			DefaultLimit:       filter.DefaultLimit.ValueInt64(),
+			DefaultPeriod:      filter.DefaultPeriod.ValueInt64(),
+			Key:                filter.Key.ValueString(),
+			Limit:              filter.Limit.ValueInt64(),
+			MinSize:            filter.MinSize.ValueInt64(),
+			SpoeConfig:         filter.SpoeConfig.ValueString(),
+			SpoeEngine:         filter.SpoeEngine.ValueString(),
+			Table:              filter.Table.ValueString(),
+			TraceName:          filter.TraceName.ValueString(),
+			TraceHexdump:       filter.TraceHexdump.ValueBool(),
+			TraceRndForwarding: filter.TraceRndForwarding.ValueBool(),
+			TraceRndParsing:    filter.TraceRndParsing.ValueBool(),
+		})
+	}
+	return payloads
+}
+
+// convertFromFilterPayload converts a payload to a filter block
+func (r *FilterManager) convertFromFilterPayload(payload FilterPayload) haproxyFilterModel {
+	return haproxyFilterModel{
+		Type:               types.StringValue(payload.Type),
+		AppName:            stringOrNull(payload.AppName),
+		BandwidthLimitName: stringOrNull(payload.BandwidthLimitName),
+		CacheName:          stringOrNull(payload.CacheName),
+		DefaultLimit:       int64OrNull(payload.DefaultLimit),
+		DefaultPeriod:      int64OrNull(payload.DefaultPeriod),
+		Key:                stringOrNull(payload.Key),
+		Limit:              int64OrNull(payload.Limit),
+		MinSize:            int64OrNull(payload.MinSize),
+		SpoeConfig:         stringOrNull(payload.SpoeConfig),
+		SpoeEngine:         stringOrNull(payload.SpoeEngine),
+		Table:              stringOrNull(payload.Table),
+		TraceName:          stringOrNull(payload.TraceName),
+		TraceHexdump:       boolOrNull(payload.TraceHexdump),
+		TraceRndForwarding: boolOrNull(payload.TraceRndForwarding),
+		TraceRndParsing:    boolOrNull(payload.TraceRndParsing),
+	}
+}
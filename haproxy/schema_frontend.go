@@ -91,6 +91,10 @@ func GetFrontendSchema() schema.SingleNestedBlock {
 				Optional:    true,
 				Description: "The URI to use for health monitoring of the frontend.",
 			},
+			"defaults": schema.StringAttribute{
+				Optional:    true,
+				Description: "The name of the defaults section the frontend inherits from.",
+			},
 			"binds": GetBindSchema(),
 		},
 		Blocks: map[string]schema.Block{
@@ -135,10 +139,15 @@ func GetFrontendSchema() schema.SingleNestedBlock {
 					},
 				},
 			},
-			"acls":                GetACLSchema(),
-			"http_request_rules":  GetHttpRequestRuleSchema(),
-			"http_response_rules": GetHttpResponseRuleSchema(),
-			"tcp_request_rules":   GetTcpRequestRuleSchema(),
+			"acls":                      GetACLSchema(),
+			"http_request_rules":        GetHttpRequestRuleSchema(),
+			"http_response_rules":       GetHttpResponseRuleSchema(),
+			"http_after_response_rules": GetHttpAfterResponseRuleSchema(),
+			"tcp_request_rules":         GetTcpRequestRuleSchema(),
+			"backend_switching_rules":   GetBackendSwitchingRuleSchema(),
+			"compression":               GetCompressionSchema(),
+			"filters":                   GetFilterSchema(),
+			"error_files":               GetErrorFilesSchema(),
 		},
 	}
 }
@@ -0,0 +1,302 @@
+package haproxy
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// GetHttpAfterResponseRuleSchema returns the schema for the http_after_response_rules block
+func GetHttpAfterResponseRuleSchema() schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		Description: "HTTP after-response rules (http-after-response, HAProxy 2.2+). Unlike http_response_rules they also apply to responses generated by HAProxy itself (redirects, deny pages, errors). Rules are applied in the order they are declared.",
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"type": schema.StringAttribute{
+					Required:    true,
+					Description: "The action of the rule (add-header, allow, del-header, del-map, replace-header, replace-value, set-header, set-log-level, set-map, set-status, set-var, strict-mode, unset-var).",
+					Validators: []validator.String{
+						stringvalidator.OneOf(
+							"add-header", "allow", "del-header", "del-map", "replace-header", "replace-value",
+							"set-header", "set-log-level", "set-map", "set-status", "set-var", "strict-mode", "unset-var",
+						),
+					},
+				},
+				"cond": schema.StringAttribute{
+					Optional:    true,
+					Description: "The condition of the rule (if, unless).",
+					Validators: []validator.String{
+						stringvalidator.OneOf("if", "unless"),
+					},
+				},
+				"cond_test": schema.StringAttribute{
+					Optional:    true,
+					Description: "The condition test of the rule.",
+				},
+				"hdr_name": schema.StringAttribute{
+					Optional:    true,
+					Description: "The header name, for the header actions.",
+				},
+				"hdr_format": schema.StringAttribute{
+					Optional:    true,
+					Description: "The header value (log-format), for add-header, set-header, replace-header and replace-value.",
+				},
+				"hdr_match": schema.StringAttribute{
+					Optional:    true,
+					Description: "The regular expression matched against the header, for replace-header and replace-value.",
+				},
+				"status": schema.Int64Attribute{
+					Optional:    true,
+					Description: "The response status code, for set-status.",
+					Validators: []validator.Int64{
+						int64validator.Between(100, 999),
+					},
+				},
+				"status_reason": schema.StringAttribute{
+					Optional:    true,
+					Description: "The response reason phrase, for set-status.",
+				},
+				"var_name": schema.StringAttribute{
+					Optional:    true,
+					Description: "The variable name, for set-var and unset-var.",
+				},
+				"var_scope": schema.StringAttribute{
+					Optional:    true,
+					Description: "The variable scope (proc, sess, txn, req, res), for set-var and unset-var.",
+					Validators: []validator.String{
+						stringvalidator.OneOf("proc", "sess", "txn", "req", "res"),
+					},
+				},
+				"var_expr": schema.StringAttribute{
+					Optional:    true,
+					Description: "The sample expression stored in the variable, for set-var.",
+				},
+				"map_file": schema.StringAttribute{
+					Optional:    true,
+					Description: "The map file, for set-map and del-map.",
+				},
+				"map_keyfmt": schema.StringAttribute{
+					Optional:    true,
+					Description: "The key of the map entry (log-format), for set-map and del-map.",
+				},
+				"map_valuefmt": schema.StringAttribute{
+					Optional:    true,
+					Description: "The value of the map entry (log-format), for set-map.",
+				},
+				"log_level": schema.StringAttribute{
+					Optional:    true,
+					Description: "The log level, for set-log-level.",
+					Validators: []validator.String{
+						stringvalidator.OneOf("emerg", "alert", "crit", "err", "warning", "notice", "info", "debug", "silent"),
+					},
+				},
+				"strict_mode": schema.StringAttribute{
+					Optional:    true,
+					Description: "Whether the strict rewriting mode is enabled (on, off), for strict-mode.",
+					Validators: []validator.String{
+						stringvalidator.OneOf("on", "off"),
+					},
+				},
+			},
+		},
+	}
+}
+
+// httpAfterResponseRuleRequiredArguments lists the arguments each http-after-response action needs.
+var httpAfterResponseRuleRequiredArguments = map[string][]string{
+	"add-header":     {"hdr_name", "hdr_format"},
+	"del-header":     {"hdr_name"},
+	"del-map":        {"map_file", "map_keyfmt"},
+	"replace-header": {"hdr_name", "hdr_match", "hdr_format"},
+	"replace-value":  {"hdr_name", "hdr_match", "hdr_format"},
+	"set-header":     {"hdr_name", "hdr_format"},
+	"set-log-level":  {"log_level"},
+	"set-map":        {"map_file", "map_keyfmt", "map_valuefmt"},
+	"set-status":     {"status"},
+	"set-var":        {"var_name", "var_scope", "var_expr"},
+	"strict-mode":    {"strict_mode"},
+	"unset-var":      {"var_name", "var_scope"},
+}
+
+// validate checks that the rule sets the arguments its action needs, and that the
+// condition and its test are set together.
+func (r haproxyHttpAfterResponseRuleModel) validate(diags *diag.Diagnostics, rulePath path.Path) {
+	if r.Type.IsNull() || r.Type.IsUnknown() {
+		return
+	}
+
+	arguments := map[string]attr.Value{
+		"hdr_name":     r.HdrName,
+		"hdr_format":   r.HdrFormat,
+		"hdr_match":    r.HdrMatch,
+		"status":       r.Status,
+		"var_name":     r.VarName,
+		"var_scope":    r.VarScope,
+		"var_expr":     r.VarExpr,
+		"map_file":     r.MapFile,
+		"map_keyfmt":   r.MapKeyfmt,
+		"map_valuefmt": r.MapValuefmt,
+		"log_level":    r.LogLevel,
+		"strict_mode":  r.StrictMode,
+	}
+	for _, name := range httpAfterResponseRuleRequiredArguments[r.Type.ValueString()] {
+		if arguments[name].IsNull() {
+			diags.AddAttributeError(rulePath.AtName(name), "Missing "+name,
+				fmt.Sprintf("%s is required for %s rules.", name, r.Type.ValueString()))
+		}
+	}
+
+	hasCond := !r.Cond.IsNull() && r.Cond.ValueString() != ""
+	hasCondTest := !r.CondTest.IsNull() && r.CondTest.ValueString() != ""
+	if hasCond && !hasCondTest && !r.CondTest.IsUnknown() {
+		diags.AddAttributeError(rulePath.AtName("cond_test"), "Missing cond_test",
+			"cond_test is required when cond is set.")
+	}
+	if hasCondTest && !hasCond && !r.Cond.IsUnknown() {
+		diags.AddAttributeError(rulePath.AtName("cond"), "Missing cond",
+			"cond (if or unless) is required when cond_test is set.")
+	}
+}
+
+// HttpAfterResponseRuleManager manages the http-after-response rules of a frontend or backend
+type HttpAfterResponseRuleManager struct {
+	client *HAProxyClient
+}
+
+// CreateHttpAfterResponseRuleManager creates a new http-after-response rule manager
+func CreateHttpAfterResponseRuleManager(client *HAProxyClient) *HttpAfterResponseRuleManager {
+	return &HttpAfterResponseRuleManager{
+		client: client,
+	}
+}
+
+// Create creates http-after-response rules
+func (r *HttpAfterResponseRuleManager) Create(ctx context.Context, transactionID, parentType, parentName string, rules []haproxyHttpAfterResponseRuleModel) error {
+	if len(rules) == 0 {
+		return nil
+	}
+
+	log.Printf("Creating %d http-after-response rules for %s %s", len(rules), parentType, parentName)
+
+	if err := r.client.CreateAllHttpAfterResponseRulesInTransaction(ctx, transactionID, parentType, parentName, r.convertToHttpAfterResponseRulePayloads(rules)); err != nil {
+		return fmt.Errorf("failed to create http-after-response rules for %s %s: %w", parentType, parentName, err)
+	}
+
+	return nil
+}
+
+// Read reads http-after-response rules
+func (r *HttpAfterResponseRuleManager) Read(ctx context.Context, parentType, parentName string) ([]haproxyHttpAfterResponseRuleModel, error) {
+	payloads, err := r.client.ReadHttpAfterResponseRules(ctx, parentType, parentName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read http-after-response rules for %s %s: %w", parentType, parentName, err)
+	}
+
+	sort.Slice(payloads, func(i, j int) bool {
+		return payloads[i].Index < payloads[j].Index
+	})
+
+	rules := make([]haproxyHttpAfterResponseRuleModel, 0, len(payloads))
+	for _, payload := range payloads {
+		rules = append(rules, r.convertFromHttpAfterResponseRulePayload(payload))
+	}
+	return rules, nil
+}
+
+// Update replaces the http-after-response rules of a parent
+func (r *HttpAfterResponseRuleManager) Update(ctx context.Context, transactionID, parentType, parentName string, rules []haproxyHttpAfterResponseRuleModel) error {
+	return r.client.replaceAllInTransaction(
+		func() error { return r.Delete(ctx, transactionID, parentType, parentName) },
+		func() error {
+			if err := r.client.CreateAllHttpAfterResponseRulesInTransaction(ctx, transactionID, parentType, parentName, r.convertToHttpAfterResponseRulePayloads(rules)); err != nil {
+				return fmt.Errorf("failed to replace http-after-response rules for %s %s: %w", parentType, parentName, err)
+			}
+			return nil
+		},
+	)
+}
+
+// Delete deletes all http-after-response rules of a parent
+func (r *HttpAfterResponseRuleManager) Delete(ctx context.Context, transactionID, parentType, parentName string) error {
+	existingRules, err := r.client.ReadHttpAfterResponseRules(ctx, parentType, parentName)
+	if err != nil {
+		return fmt.Errorf("failed to read existing http-after-response rules for deletion: %w", err)
+	}
+
+	// Delete in reverse order (highest index first) to avoid shifting issues
+	sort.Slice(existingRules, func(i, j int) bool {
+		return existingRules[i].Index > existingRules[j].Index
+	})
+
+	for _, rule := range existingRules {
+		if err := r.client.DeleteHttpAfterResponseRuleInTransaction(ctx, transactionID, rule.Index, parentType, parentName); err != nil {
+			return fmt.Errorf("failed to delete http-after-response rule at index %d: %w", rule.Index, err)
+		}
+	}
+
+	log.Printf("Deleted %d http-after-response rules for %s %s", len(existingRules), parentType, parentName)
+	return nil
+}
+
+// convertToHttpAfterResponseRulePayloads converts http-after-response rule blocks to payloads, indexed by position
+func (r *HttpAfterResponseRuleManager) convertToHttpAfterResponseRulePayloads(rules []haproxyHttpAfterResponseRuleModel) []HttpAfterResponseRulePayload {
+	payloads := make([]HttpAfterResponseRulePayload, 0, len(rules))
+	for i, rule := range rules {
+		payloads = append(payloads, HttpAfterResponseRulePayload{
+			Index:        int64(i),
+			Type:         rule.Type.ValueString(),
+			Cond:         rule.Cond.ValueString(),
+			CondTest:     rule.CondTest.ValueString(),
+			HdrName:      rule.HdrName.ValueString(),
+			HdrFormat:    rule.HdrFormat.ValueString(),
+			HdrMatch:     rule.HdrMatch.ValueString(),
+			Status:       rule.Status.ValueInt64(),
+			StatusReason: rule.StatusReason.ValueString(),
+			VarName:      rule.VarName.ValueString(),
+			VarScope:     rule.VarScope.ValueString(),
+			VarExpr:      rule.VarExpr.ValueString(),
+			MapFile:      rule.MapFile.ValueString(),
+			MapKeyfmt:    rule.MapKeyfmt.ValueString(),
+			MapValuefmt:  rule.MapValuefmt.ValueString(),
+			LogLevel:     rule.LogLevel.ValueString(),
+			StrictMode:   rule.StrictMode.ValueString(),
+		})
+	}
+	return payloads
+}
+
+// convertFromHttpAfterResponseRulePayload converts a payload to an http-after-response rule block
+func (r *HttpAfterResponseRuleManager) convertFromHttpAfterResponseRulePayload(payload HttpAfterResponseRulePayload) haproxyHttpAfterResponseRuleModel {
+	rule := haproxyHttpAfterResponseRuleModel{
+		Type:         types.StringValue(payload.Type),
+		Cond:         stringOrNull(payload.Cond),
+		CondTest:     stringOrNull(payload.CondTest),
+		HdrName:      stringOrNull(payload.HdrName),
+		HdrFormat:    stringOrNull(payload.HdrFormat),
+		HdrMatch:     stringOrNull(payload.HdrMatch),
+		Status:       types.Int64Null(),
+		StatusReason: stringOrNull(payload.StatusReason),
+		VarName:      stringOrNull(payload.VarName),
+		VarScope:     stringOrNull(payload.VarScope),
+		VarExpr:      stringOrNull(payload.VarExpr),
+		MapFile:      stringOrNull(payload.MapFile),
+		MapKeyfmt:    stringOrNull(payload.MapKeyfmt),
+		MapValuefmt:  stringOrNull(payload.MapValuefmt),
+		LogLevel:     stringOrNull(payload.LogLevel),
+		StrictMode:   stringOrNull(payload.StrictMode),
+	}
+	if payload.Status != 0 {
+		rule.Status = types.Int64Value(payload.Status)
+	}
+	return rule
+}
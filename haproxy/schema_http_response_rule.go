@@ -4,13 +4,16 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"reflect"
 	"sort"
 	"strconv"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -94,7 +97,7 @@ func GetHttpResponseRuleSchema() schema.ListNestedBlock {
 				},
 				"cache_name": schema.StringAttribute{
 					Optional:    true,
-					Description: "The cache name for the HTTP response rule.",
+					Description: "The name of the cache (see haproxy_cache) written by a cache-store rule.",
 				},
 				"capture_id": schema.Int64Attribute{
 					Optional:    true,
@@ -269,111 +272,53 @@ func GetHttpResponseRuleSchema() schema.ListNestedBlock {
 	}
 }
 
+// validateCacheAction checks that cache-store names its cache and that cache-use, which
+// only exists as an HTTP request action, is not used on a response rule.
+func (m haproxyHttpResponseRuleModel) validateCacheAction(diags *diag.Diagnostics, rulePath path.Path) {
+	if m.Type.IsUnknown() {
+		return
+	}
+	switch m.Type.ValueString() {
+	case "cache-store":
+		if !m.CacheName.IsUnknown() && m.CacheName.ValueString() == "" {
+			diags.AddAttributeError(rulePath.AtName("cache_name"), "Missing cache name",
+				"The cache-store action requires cache_name, the name of an haproxy_cache.")
+		}
+	case "cache-use":
+		diags.AddAttributeError(rulePath.AtName("type"), "Invalid HTTP response action",
+			"cache-use is an HTTP request action; declare it in http_request_rules.")
+	default:
+		if !m.CacheName.IsNull() && !m.CacheName.IsUnknown() {
+			diags.AddAttributeError(rulePath.AtName("cache_name"), "Unexpected cache name",
+				"cache_name is only used by the cache-store action.")
+		}
+	}
+}
+
 // HttpResponseRuleResource is the resource implementation.
 type HttpResponseRuleResource struct {
-	client *HAProxyClient
+	client  *HAProxyClient
+	manager *HttpResponseRuleManager
 }
 
 // HttpResponseRuleResourceModel maps the resource schema data.
+// The rule fields are shared with the http_response_rules block of haproxy_stack.
 type HttpResponseRuleResourceModel struct {
-	ID                   types.String `tfsdk:"id"`
-	ParentType           types.String `tfsdk:"parent_type"`
-	ParentName           types.String `tfsdk:"parent_name"`
-	Index                types.Int64  `tfsdk:"index"`
-	Type                 types.String `tfsdk:"type"`
-	Cond                 types.String `tfsdk:"cond"`
-	CondTest             types.String `tfsdk:"cond_test"`
-	HdrName              types.String `tfsdk:"hdr_name"`
-	HdrFormat            types.String `tfsdk:"hdr_format"`
-	HdrMatch             types.String `tfsdk:"hdr_match"`
-	HdrMethod            types.String `tfsdk:"hdr_method"`
-	RedirType            types.String `tfsdk:"redir_type"`
-	RedirValue           types.String `tfsdk:"redir_value"`
-	RedirCode            types.Int64  `tfsdk:"redir_code"`
-	RedirOption          types.String `tfsdk:"redir_option"`
-	PathMatch            types.String `tfsdk:"path_match"`
-	PathFmt              types.String `tfsdk:"path_fmt"`
-	UriMatch             types.String `tfsdk:"uri_match"`
-	UriFmt               types.String `tfsdk:"uri_fmt"`
-	QueryFmt             types.String `tfsdk:"query_fmt"`
-	MethodFmt            types.String `tfsdk:"method_fmt"`
-	VarName              types.String `tfsdk:"var_name"`
-	VarFormat            types.String `tfsdk:"var_format"`
-	VarExpr              types.String `tfsdk:"var_expr"`
-	VarScope             types.String `tfsdk:"var_scope"`
-	CaptureID            types.Int64  `tfsdk:"capture_id"`
-	CaptureSample        types.String `tfsdk:"capture_sample"`
-	LogLevel             types.String `tfsdk:"log_level"`
-	Timeout              types.String `tfsdk:"timeout"`
-	TimeoutType          types.String `tfsdk:"timeout_type"`
-	StrictMode           types.String `tfsdk:"strict_mode"`
-	NiceValue            types.Int64  `tfsdk:"nice_value"`
-	MarkValue            types.String `tfsdk:"mark_value"`
-	TosValue             types.String `tfsdk:"tos_value"`
-	TrackScKey           types.String `tfsdk:"track_sc_key"`
-	TrackScTable         types.String `tfsdk:"track_sc_table"`
-	TrackScID            types.Int64  `tfsdk:"track_sc_id"`
-	TrackScIdx           types.Int64  `tfsdk:"track_sc_idx"`
-	TrackScInt           types.Int64  `tfsdk:"track_sc_int"`
-	TrackScStickCounter  types.Int64  `tfsdk:"track_sc_stick_counter"`
-	ReturnStatusCode     types.Int64  `tfsdk:"return_status_code"`
-	ReturnContent        types.String `tfsdk:"return_content"`
-	ReturnContentType    types.String `tfsdk:"return_content_type"`
-	ReturnContentFormat  types.String `tfsdk:"return_content_format"`
-	DenyStatus           types.Int64  `tfsdk:"return_deny_status"`
-	WaitTime             types.Int64  `tfsdk:"wait_time"`
-	WaitAtLeast          types.Int64  `tfsdk:"wait_at_least"`
-	Expr                 types.String `tfsdk:"expr"`
-	LuaAction            types.String `tfsdk:"lua_action"`
-	LuaParams            types.String `tfsdk:"lua_params"`
-	SpoeEngine           types.String `tfsdk:"spoe_engine"`
-	SpoeGroup            types.String `tfsdk:"spoe_group"`
-	ServiceName          types.String `tfsdk:"service_name"`
-	CacheName            types.String `tfsdk:"cache_name"`
-	Resolvers            types.String `tfsdk:"resolvers"`
-	Protocol             types.String `tfsdk:"protocol"`
-	BandwidthLimitName   types.String `tfsdk:"bandwidth_limit_name"`
-	BandwidthLimitLimit  types.String `tfsdk:"bandwidth_limit_limit"`
-	BandwidthLimitPeriod types.String `tfsdk:"bandwidth_limit_period"`
-	MapFile              types.String `tfsdk:"map_file"`
-	MapKeyfmt            types.String `tfsdk:"map_keyfmt"`
-	MapValuefmt          types.String `tfsdk:"map_valuefmt"`
-	AclFile              types.String `tfsdk:"acl_file"`
-	AclKeyfmt            types.String `tfsdk:"acl_keyfmt"`
-	AuthRealm            types.String `tfsdk:"auth_realm"`
-	HintName             types.String `tfsdk:"hint_name"`
-	HintFormat           types.String `tfsdk:"hint_format"`
-	ScExpr               types.String `tfsdk:"sc_expr"`
-	ScID                 types.Int64  `tfsdk:"sc_id"`
-	ScIdx                types.Int64  `tfsdk:"sc_idx"`
-	ScInt                types.Int64  `tfsdk:"sc_int"`
-	ScAddGpc             types.String `tfsdk:"sc_add_gpc"`
-	ScIncGpc             types.String `tfsdk:"sc_inc_gpc"`
-	ScIncGpc0            types.String `tfsdk:"sc_inc_gpc0"`
-	ScIncGpc1            types.String `tfsdk:"sc_inc_gpc1"`
-	ScSetGpt             types.String `tfsdk:"sc_set_gpt"`
-	ScSetGpt0            types.String `tfsdk:"sc_set_gpt0"`
-	SetPriorityClass     types.String `tfsdk:"set_priority_class"`
-	SetPriorityOffset    types.String `tfsdk:"set_priority_offset"`
-	SetRetries           types.String `tfsdk:"set_retries"`
-	SetBcMark            types.String `tfsdk:"set_bc_mark"`
-	SetBcTos             types.String `tfsdk:"set_bc_tos"`
-	SetFcMark            types.String `tfsdk:"set_fc_mark"`
-	SetFcTos             types.String `tfsdk:"set_fc_tos"`
-	SetSrc               types.String `tfsdk:"set_src"`
-	SetSrcPort           types.Int64  `tfsdk:"set_src_port"`
-	SetDst               types.String `tfsdk:"set_dst"`
-	SetDstPort           types.Int64  `tfsdk:"set_dst_port"`
-	SetMethod            types.String `tfsdk:"set_method"`
-	SetPath              types.String `tfsdk:"set_path"`
-	SetPathq             types.String `tfsdk:"set_pathq"`
-	SetQuery             types.String `tfsdk:"set_query"`
-	SetUri               types.String `tfsdk:"set_uri"`
-	SetVar               types.String `tfsdk:"set_var"`
-	SetVarFmt            types.String `tfsdk:"set_var_fmt"`
-	UnsetVar             types.String `tfsdk:"unset_var"`
-	SilentDrop           types.Bool   `tfsdk:"silent_drop"`
-	DoLog                types.Bool   `tfsdk:"do_log"`
+	ID         types.String `tfsdk:"id"`
+	ParentType types.String `tfsdk:"parent_type"`
+	ParentName types.String `tfsdk:"parent_name"`
+	haproxyHttpResponseRuleModel
+}
+
+var (
+	_ resource.Resource                = &HttpResponseRuleResource{}
+	_ resource.ResourceWithConfigure   = &HttpResponseRuleResource{}
+	_ resource.ResourceWithImportState = &HttpResponseRuleResource{}
+)
+
+// NewHttpResponseRuleResource is a helper function to simplify the provider implementation.
+func NewHttpResponseRuleResource() resource.Resource {
+	return &HttpResponseRuleResource{}
 }
 
 // Metadata returns the resource type name.
@@ -381,22 +326,43 @@ func (r *HttpResponseRuleResource) Metadata(_ context.Context, req resource.Meta
 	resp.TypeName = req.ProviderTypeName + "_http_response_rule"
 }
 
+// Schema defines the schema for the resource.
+func (r *HttpResponseRuleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := GetHttpResponseRuleSchema().NestedObject.Attributes
+	for name, attribute := range GetParentAttributes("frontend", "backend") {
+		attributes[name] = attribute
+	}
+	attributes["index"] = schema.Int64Attribute{
+		Required:    true,
+		Description: "The position of the rule in the parent's http-response rule list.",
+		PlanModifiers: []planmodifier.Int64{
+			int64planmodifier.RequiresReplace(),
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Manages a single HTTP response rule on a frontend or backend. It can be combined with http_response_rules on an haproxy_stack that owns the same parent, since the stack only rewrites its own rules. Rules are positional, so adding or removing stack rules placed before this one shifts its index.",
+		Attributes:  attributes,
+	}
+}
+
 // Configure adds the provider configured client to the resource.
 func (r *HttpResponseRuleResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
 
-	client, ok := req.ProviderData.(*HAProxyClient)
+	providerData, ok := req.ProviderData.(*ProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
-			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *HAProxyClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	r.client = client
+	r.client = providerData.Client
+	r.manager = CreateHttpResponseRuleManager(providerData.Client)
 }
 
 // Create creates the resource and sets the initial Terraform state.
@@ -408,9 +374,25 @@ func (r *HttpResponseRuleResource) Create(ctx context.Context, req resource.Crea
 		return
 	}
 
-	// Individual HTTP response rule resources should only be used within haproxy_stack context
-	// This resource is not registered and should not be used standalone
-	resp.Diagnostics.AddError("Invalid Usage", "HTTP response rule resources should only be used within haproxy_stack context. Use haproxy_stack resource instead.")
+	parentType := plan.ParentType.ValueString()
+	parentName := plan.ParentName.ValueString()
+	index := plan.Index.ValueInt64()
+	payload := r.manager.convertToHttpResponseRulePayload(&plan.haproxyHttpResponseRuleModel, int(index))
+
+	err := r.client.InTransaction(func(transactionID string) error {
+		return r.client.CreateHttpResponseRuleInTransaction(ctx, transactionID, parentType, parentName, payload)
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating HTTP response rule",
+			fmt.Sprintf("Could not create HTTP response rule %d on %s %s: %s", index, parentType, parentName, err),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(fmt.Sprintf("%s/%s/%d", parentType, parentName, index))
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -422,7 +404,6 @@ func (r *HttpResponseRuleResource) Read(ctx context.Context, req resource.ReadRe
 		return
 	}
 
-	// Read the HTTP response rule
 	rules, err := r.client.ReadHttpResponseRules(ctx, state.ParentType.ValueString(), state.ParentName.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -432,34 +413,20 @@ func (r *HttpResponseRuleResource) Read(ctx context.Context, req resource.ReadRe
 		return
 	}
 
-	// Find the specific rule by index
-	var foundRule *HttpResponseRulePayload
-	for i, rule := range rules {
-		if int64(i) == state.Index.ValueInt64() {
-			foundRule = &rule
-			break
-		}
-	}
-
-	if foundRule == nil {
+	// Rules are addressed by position; if the list has shrunk the rule is gone
+	index := state.Index.ValueInt64()
+	if index < 0 || index >= int64(len(rules)) {
 		resp.State.RemoveResource(ctx)
 		return
 	}
 
-	// Update state
-	state.Type = types.StringValue(foundRule.Type)
-	state.Cond = types.StringValue(foundRule.Cond)
-	state.CondTest = types.StringValue(foundRule.CondTest)
-	state.HdrName = types.StringValue(foundRule.HdrName)
-	state.HdrFormat = types.StringValue(foundRule.HdrFormat)
-	state.HdrMatch = types.StringValue(foundRule.HdrMatch)
-	state.HdrMethod = types.StringValue(foundRule.HdrMethod)
-	state.RedirType = types.StringValue(foundRule.RedirType)
-	state.RedirValue = types.StringValue(foundRule.RedirValue)
-	state.ReturnStatusCode = types.Int64Value(foundRule.StatusCode)
-	state.ReturnContent = types.StringValue(foundRule.StatusReason)
-
-	// Set state
+	rule := r.manager.convertFromHttpResponseRulePayload(&rules[index])
+	rule.Index = state.Index
+	// action is not stored by HAProxy, keep the configured value
+	rule.Action = state.Action
+	state.haproxyHttpResponseRuleModel = rule
+	state.ID = types.StringValue(fmt.Sprintf("%s/%s/%d", state.ParentType.ValueString(), state.ParentName.ValueString(), index))
+
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
 }
@@ -473,9 +440,25 @@ func (r *HttpResponseRuleResource) Update(ctx context.Context, req resource.Upda
 		return
 	}
 
-	// Individual HTTP response rule resources should only be used within haproxy_stack context
-	// This resource is not registered and should not be used standalone
-	resp.Diagnostics.AddError("Invalid Usage", "HTTP response rule resources should only be used within haproxy_stack context. Use haproxy_stack resource instead.")
+	parentType := plan.ParentType.ValueString()
+	parentName := plan.ParentName.ValueString()
+	index := plan.Index.ValueInt64()
+	payload := r.manager.convertToHttpResponseRulePayload(&plan.haproxyHttpResponseRuleModel, int(index))
+
+	err := r.client.InTransaction(func(transactionID string) error {
+		return r.client.UpdateHttpResponseRuleInTransaction(ctx, transactionID, index, parentType, parentName, payload)
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating HTTP response rule",
+			fmt.Sprintf("Could not update HTTP response rule %d on %s %s: %s", index, parentType, parentName, err),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(fmt.Sprintf("%s/%s/%d", parentType, parentName, index))
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
@@ -487,27 +470,30 @@ func (r *HttpResponseRuleResource) Delete(ctx context.Context, req resource.Dele
 		return
 	}
 
-	// Individual HTTP response rule resources should only be used within haproxy_stack context
-	// This resource is not registered and should not be used standalone
-	resp.Diagnostics.AddError("Invalid Usage", "HTTP response rule resources should only be used within haproxy_stack context. Use haproxy_stack resource instead.")
+	parentType := state.ParentType.ValueString()
+	parentName := state.ParentName.ValueString()
+	index := state.Index.ValueInt64()
+
+	err := r.client.InTransaction(func(transactionID string) error {
+		return r.client.DeleteHttpResponseRuleInTransaction(ctx, transactionID, index, parentType, parentName)
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting HTTP response rule",
+			fmt.Sprintf("Could not delete HTTP response rule %d on %s %s: %s", index, parentType, parentName, err),
+		)
+	}
 }
 
 // ImportState configures the resource for import.
 func (r *HttpResponseRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Parse the import ID format: parent_type/parent_name/index
-	parts := strings.Split(req.ID, "/")
-	if len(parts) != 3 {
-		resp.Diagnostics.AddError(
-			"Invalid import ID",
-			"Import ID must be in the format: parent_type/parent_name/index",
-		)
+	parentType, parentName, indexStr, err := parseChildImportID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", err.Error())
 		return
 	}
 
-	parentType := parts[0]
-	parentName := parts[1]
-	indexStr := parts[2]
-
 	index, err := strconv.ParseInt(indexStr, 10, 64)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -518,6 +504,7 @@ func (r *HttpResponseRuleResource) ImportState(ctx context.Context, req resource
 	}
 
 	// Set the imported values
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("parent_type"), parentType)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("parent_name"), parentName)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("index"), index)...)
@@ -821,6 +808,188 @@ func (r *HttpResponseRuleManager) convertToHttpResponseRulePayload(rule *haproxy
 	return payload
 }
 
+// convertFromHttpResponseRulePayload converts HAProxy API payload to the stack HTTP response rule model
+func (r *HttpResponseRuleManager) convertFromHttpResponseRulePayload(payload *HttpResponseRulePayload) haproxyHttpResponseRuleModel {
+	model := haproxyHttpResponseRuleModel{
+		Type: types.StringValue(payload.Type),
+	}
+
+	// Set optional fields only if HAProxy returned a value
+	if payload.AclFile != "" {
+		model.AclFile = types.StringValue(payload.AclFile)
+	}
+	if payload.AclKeyfmt != "" {
+		model.AclKeyfmt = types.StringValue(payload.AclKeyfmt)
+	}
+	if payload.AuthRealm != "" {
+		model.AuthRealm = types.StringValue(payload.AuthRealm)
+	}
+	if payload.BandwidthLimitLimit != "" {
+		model.BandwidthLimitLimit = types.StringValue(payload.BandwidthLimitLimit)
+	}
+	if payload.BandwidthLimitName != "" {
+		model.BandwidthLimitName = types.StringValue(payload.BandwidthLimitName)
+	}
+	if payload.BandwidthLimitPeriod != "" {
+		model.BandwidthLimitPeriod = types.StringValue(payload.BandwidthLimitPeriod)
+	}
+	if payload.CacheName != "" {
+		model.CacheName = types.StringValue(payload.CacheName)
+	}
+	if payload.CaptureID != 0 {
+		model.CaptureId = types.Int64Value(payload.CaptureID)
+	}
+	if payload.CaptureSample != "" {
+		model.CaptureSample = types.StringValue(payload.CaptureSample)
+	}
+	if payload.Cond != "" {
+		model.Cond = types.StringValue(payload.Cond)
+	}
+	if payload.CondTest != "" {
+		model.CondTest = types.StringValue(payload.CondTest)
+	}
+	if payload.DenyStatus != 0 {
+		model.DenyStatus = types.Int64Value(payload.DenyStatus)
+	}
+	if payload.Expr != "" {
+		model.Expr = types.StringValue(payload.Expr)
+	}
+	if payload.HdrFormat != "" {
+		model.HdrFormat = types.StringValue(payload.HdrFormat)
+	}
+	if payload.HdrMatch != "" {
+		model.HdrMatch = types.StringValue(payload.HdrMatch)
+	}
+	if payload.HdrMethod != "" {
+		model.HdrMethod = types.StringValue(payload.HdrMethod)
+	}
+	if payload.HdrName != "" {
+		model.HdrName = types.StringValue(payload.HdrName)
+	}
+	if payload.HintFormat != "" {
+		model.HintFormat = types.StringValue(payload.HintFormat)
+	}
+	if payload.HintName != "" {
+		model.HintName = types.StringValue(payload.HintName)
+	}
+	if payload.LogLevel != "" {
+		model.LogLevel = types.StringValue(payload.LogLevel)
+	}
+	if payload.LuaAction != "" {
+		model.LuaAction = types.StringValue(payload.LuaAction)
+	}
+	if payload.LuaParams != "" {
+		model.LuaParams = types.StringValue(payload.LuaParams)
+	}
+	if payload.MapFile != "" {
+		model.MapFile = types.StringValue(payload.MapFile)
+	}
+	if payload.MapKeyfmt != "" {
+		model.MapKeyfmt = types.StringValue(payload.MapKeyfmt)
+	}
+	if payload.MapValuefmt != "" {
+		model.MapValuefmt = types.StringValue(payload.MapValuefmt)
+	}
+	if payload.MarkValue != "" {
+		model.MarkValue = types.StringValue(payload.MarkValue)
+	}
+	if payload.NiceValue != 0 {
+		model.NiceValue = types.Int64Value(payload.NiceValue)
+	}
+	if payload.RedirCode != 0 {
+		model.RedirCode = types.Int64Value(payload.RedirCode)
+	}
+	if payload.RedirOption != "" {
+		model.RedirOption = types.StringValue(payload.RedirOption)
+	}
+	if payload.RedirType != "" {
+		model.RedirType = types.StringValue(payload.RedirType)
+	}
+	if payload.RedirValue != "" {
+		model.RedirValue = types.StringValue(payload.RedirValue)
+	}
+	if payload.ReturnContent != "" {
+		model.ReturnContent = types.StringValue(payload.ReturnContent)
+	}
+	if payload.ReturnContentFormat != "" {
+		model.ReturnContentFormat = types.StringValue(payload.ReturnContentFormat)
+	}
+	if payload.ReturnContentType != "" {
+		model.ReturnContentType = types.StringValue(payload.ReturnContentType)
+	}
+	if payload.ReturnStatusCode != 0 {
+		model.ReturnStatusCode = types.Int64Value(payload.ReturnStatusCode)
+	}
+	if payload.RstTtl != 0 {
+		model.RstTtl = types.Int64Value(payload.RstTtl)
+	}
+	if payload.ScExpr != "" {
+		model.ScExpr = types.StringValue(payload.ScExpr)
+	}
+	if payload.ScID != 0 {
+		model.ScId = types.Int64Value(payload.ScID)
+	}
+	if payload.ScIdx != 0 {
+		model.ScIdx = types.Int64Value(payload.ScIdx)
+	}
+	if payload.ScInt != 0 {
+		model.ScInt = types.Int64Value(payload.ScInt)
+	}
+	if payload.SpoeEngine != "" {
+		model.SpoeEngine = types.StringValue(payload.SpoeEngine)
+	}
+	if payload.SpoeGroup != "" {
+		model.SpoeGroup = types.StringValue(payload.SpoeGroup)
+	}
+	if payload.StatusCode != 0 {
+		model.Status = types.Int64Value(payload.StatusCode)
+	}
+	if payload.StatusReason != "" {
+		model.StatusReason = types.StringValue(payload.StatusReason)
+	}
+	if payload.StrictMode != "" {
+		model.StrictMode = types.StringValue(payload.StrictMode)
+	}
+	if payload.Timeout != "" {
+		model.Timeout = types.StringValue(payload.Timeout)
+	}
+	if payload.TimeoutType != "" {
+		model.TimeoutType = types.StringValue(payload.TimeoutType)
+	}
+	if payload.TosValue != "" {
+		model.TosValue = types.StringValue(payload.TosValue)
+	}
+	if payload.TrackScKey != "" {
+		model.TrackScKey = types.StringValue(payload.TrackScKey)
+	}
+	if payload.TrackScStickCounter != 0 {
+		model.TrackScStickCounter = types.Int64Value(payload.TrackScStickCounter)
+	}
+	if payload.TrackScTable != "" {
+		model.TrackScTable = types.StringValue(payload.TrackScTable)
+	}
+	if payload.VarExpr != "" {
+		model.VarExpr = types.StringValue(payload.VarExpr)
+	}
+	if payload.VarFormat != "" {
+		model.VarFormat = types.StringValue(payload.VarFormat)
+	}
+	if payload.VarName != "" {
+		model.VarName = types.StringValue(payload.VarName)
+	}
+	if payload.VarScope != "" {
+		model.VarScope = types.StringValue(payload.VarScope)
+	}
+	if payload.WaitAtLeast != 0 {
+		model.WaitAtLeast = types.Int64Value(payload.WaitAtLeast)
+	}
+	if payload.WaitTime != 0 {
+		model.WaitTime = types.Int64Value(payload.WaitTime)
+	}
+
+	return model
+}
+
 // updateHttpResponseRulesWithIndexing handles the complex logic of updating HTTP response rules while maintaining order
 func (r *HttpResponseRuleManager) updateHttpResponseRulesWithIndexing(ctx context.Context, parentType string, parentName string, existingRules []HttpResponseRulePayload, newRules []haproxyHttpResponseRuleModel) error {
 	// Process new rules with proper indexing and deduplication
@@ -943,7 +1112,8 @@ func (r *HttpResponseRuleManager) hasRuleChangedFromPayload(existing, desired *H
 		existing.HdrFormat != desired.HdrFormat ||
 		existing.HdrMethod != desired.HdrMethod ||
 		existing.RedirType != desired.RedirType ||
-		existing.RedirValue != desired.RedirValue
+		existing.RedirValue != desired.RedirValue ||
+		existing.CacheName != desired.CacheName
 }
 
 // deleteAllHttpResponseRules deletes all HTTP response rules for a parent resource
@@ -969,13 +1139,14 @@ func (r *HttpResponseRuleManager) deleteAllHttpResponseRules(ctx context.Context
 	return nil
 }
 
-// UpdateHttpResponseRulesInTransaction updates HTTP response rules using an existing transaction ID with smart comparison
-func (r *HttpResponseRuleManager) UpdateHttpResponseRulesInTransaction(ctx context.Context, transactionID string, parentType string, parentName string, rules []haproxyHttpResponseRuleModel) error {
-	return r.updateHttpResponseRulesWithIndexingInTransaction(ctx, transactionID, parentType, parentName, rules)
+// UpdateHttpResponseRulesInTransaction updates HTTP response rules using an existing transaction ID with smart comparison.
+// Only the rules matching managedRules (the rules the caller created before) are replaced.
+func (r *HttpResponseRuleManager) UpdateHttpResponseRulesInTransaction(ctx context.Context, transactionID string, parentType string, parentName string, rules, managedRules []haproxyHttpResponseRuleModel) error {
+	return r.updateHttpResponseRulesWithIndexingInTransaction(ctx, transactionID, parentType, parentName, rules, managedRules)
 }
 
 // updateHttpResponseRulesWithIndexingInTransaction performs smart HTTP response rule updates by comparing existing vs desired
-func (r *HttpResponseRuleManager) updateHttpResponseRulesWithIndexingInTransaction(ctx context.Context, transactionID string, parentType string, parentName string, desiredRules []haproxyHttpResponseRuleModel) error {
+func (r *HttpResponseRuleManager) updateHttpResponseRulesWithIndexingInTransaction(ctx context.Context, transactionID string, parentType string, parentName string, desiredRules, managedRules []haproxyHttpResponseRuleModel) error {
 	log.Printf("DEBUG: Starting HTTP response rule update for %s '%s' with %d desired rules", parentType, parentName, len(desiredRules))
 
 	// Read existing HTTP response rules to compare with desired ones
@@ -985,143 +1156,55 @@ func (r *HttpResponseRuleManager) updateHttpResponseRulesWithIndexingInTransacti
 	}
 
 	// Use the smart comparison logic to only update what changed
-	return r.updateHttpResponseRulesWithIndexingInTransactionSmart(ctx, transactionID, parentType, parentName, existingRules, desiredRules)
+	return r.updateHttpResponseRulesWithIndexingInTransactionSmart(ctx, transactionID, parentType, parentName, existingRules, desiredRules, managedRules)
 }
 
-// updateHttpResponseRulesWithIndexingInTransactionSmart performs smart HTTP response rule updates by comparing existing vs desired
-func (r *HttpResponseRuleManager) updateHttpResponseRulesWithIndexingInTransactionSmart(ctx context.Context, transactionID string, parentType string, parentName string, existingRules []HttpResponseRulePayload, desiredRules []haproxyHttpResponseRuleModel) error {
-	// Process desired rules with proper indexing and deduplication
-	sortedDesiredRules := r.processHttpResponseRulesBlock(desiredRules)
-
-	// Create a map of existing rules by content key for quick lookup (since HAProxy API v3 returns all with index 0)
-	existingRuleMap := make(map[string]*HttpResponseRulePayload)
-	for i := range existingRules {
-		ruleKey := r.generateRuleKeyFromPayload(&existingRules[i])
-		existingRuleMap[ruleKey] = &existingRules[i]
-	}
-
-	// Check if any rules actually changed (content OR order)
-	hasChanges := false
-	var rulesToRecreate []haproxyHttpResponseRuleModel
-
-	log.Printf("DEBUG: Comparing %d existing HTTP response rules with %d desired rules for %s %s", len(existingRules), len(sortedDesiredRules), parentType, parentName)
-
-	// First, check if the number of rules changed
-	if len(existingRules) != len(sortedDesiredRules) {
-		log.Printf("DEBUG: Rule count changed from %d to %d, marking for recreation", len(existingRules), len(sortedDesiredRules))
-		hasChanges = true
-		rulesToRecreate = sortedDesiredRules
-	} else {
-		// Check if rules have changed content OR order
-		for i, desiredRule := range sortedDesiredRules {
-			desiredKey := r.generateRuleKey(&desiredRule)
-			existingRule, exists := existingRuleMap[desiredKey]
-
-			log.Printf("DEBUG: HTTP response rule %d - desired key: '%s'", i, desiredKey)
-
-			if !exists {
-				log.Printf("DEBUG: HTTP response rule %d - no existing rule with key '%s', checking if it's a content change", i, desiredKey)
-				// Check if this might be a content change by looking for a rule at the same position
-				if i < len(existingRules) {
-					existingKey := r.generateRuleKeyFromPayload(&existingRules[i])
-					log.Printf("DEBUG: HTTP response rule %d - existing rule at position %d has key '%s'", i, i, existingKey)
-					if desiredKey != existingKey {
-						log.Printf("DEBUG: HTTP response rule %d - content change detected at position %d - desired: '%s', existing: '%s'", i, i, desiredKey, existingKey)
-						hasChanges = true
-						rulesToRecreate = sortedDesiredRules
-						break
-					}
-				} else {
-					log.Printf("DEBUG: HTTP response rule %d - truly new rule, marking for recreation", i)
-					hasChanges = true
-					rulesToRecreate = sortedDesiredRules
-					break
-				}
-			} else {
-				log.Printf("DEBUG: HTTP response rule %d - found existing rule with key '%s'", i, desiredKey)
-				changed := hasHttpResponseRuleChanged(*existingRule, *r.convertToHttpResponseRulePayload(&desiredRule, i))
-				log.Printf("DEBUG: HTTP response rule %d - hasHttpResponseRuleChanged returned: %t", i, changed)
-				if changed {
-					log.Printf("DEBUG: HTTP response rule %d - marked for recreation due to content changes", i)
-					hasChanges = true
-					rulesToRecreate = sortedDesiredRules
-					break
-				}
-			}
-		}
-
-		// If no content changes detected, check for order changes by comparing the sequence
-		if !hasChanges {
-			log.Printf("DEBUG: No content changes detected, checking for order changes...")
-			for i, desiredRule := range sortedDesiredRules {
-				desiredKey := r.generateRuleKey(&desiredRule)
-				// Check if the rule at position i has the same key as the existing rule at position i
-				if i < len(existingRules) {
-					existingKey := r.generateRuleKeyFromPayload(&existingRules[i])
-					if desiredKey != existingKey {
-						log.Printf("DEBUG: Order change detected at position %d - desired: '%s', existing: '%s'", i, desiredKey, existingKey)
-						hasChanges = true
-						rulesToRecreate = sortedDesiredRules
-						break
-					}
-				}
-			}
-		}
-	}
-
-	// Also check if any existing rules need to be removed (not in desired list)
-	if !hasChanges {
-		for existingKey := range existingRuleMap {
-			found := false
-			for _, desiredRule := range sortedDesiredRules {
-				if r.generateRuleKey(&desiredRule) == existingKey {
-					found = true
-					break
-				}
-			}
-			if !found {
-				log.Printf("DEBUG: Existing HTTP response rule '%s' not in desired list, marking for removal", existingKey)
-				hasChanges = true
-				rulesToRecreate = sortedDesiredRules
-				break
-			}
-		}
-	}
-
-	log.Printf("DEBUG: Final hasChanges result: %t, HTTP response rules to recreate: %d", hasChanges, len(rulesToRecreate))
+// updateHttpResponseRulesWithIndexingInTransactionSmart replaces the rules matching managedRules with desiredRules,
+// keeping the rules created outside the caller (e.g. haproxy_http_response_rule resources) in place
+func (r *HttpResponseRuleManager) updateHttpResponseRulesWithIndexingInTransactionSmart(ctx context.Context, transactionID string, parentType string, parentName string, existingRules []HttpResponseRulePayload, desiredRules, managedRules []haproxyHttpResponseRuleModel) error {
+	desired := r.convertToHttpResponseRulePayloads(desiredRules)
+	managed := r.convertToHttpResponseRulePayloads(managedRules)
+	merged := mergeManagedItems(existingRules, managed, desired, func(existing, managed HttpResponseRulePayload) bool {
+		return !hasHttpResponseRuleChanged(existing, managed)
+	})
 
-	// If no changes detected, skip the update
-	if !hasChanges {
+	unchanged := itemsEqual(existingRules, merged, func(a, b HttpResponseRulePayload) bool {
+		a.Index, b.Index = 0, 0
+		return reflect.DeepEqual(a, b)
+	})
+	if unchanged {
 		log.Printf("No HTTP response rule changes detected for %s %s, skipping update", parentType, parentName)
 		return nil
 	}
 
+	for i := range merged {
+		merged[i].Index = int64(i)
+	}
+
 	// First, delete all existing HTTP response rules to avoid duplicates
 	if err := r.deleteAllHttpResponseRulesInTransaction(ctx, transactionID, parentType, parentName); err != nil {
 		return fmt.Errorf("failed to delete existing HTTP response rules for %s %s: %w", parentType, parentName, err)
 	}
 
-	// Then create all desired rules using the same "create all at once" approach for both v2 and v3
-	// This ensures consistent formatting from HAProxy API
-	// Process rules with proper indexing and deduplication
-	sortedRules := r.processHttpResponseRulesBlock(desiredRules)
-
-	// Convert all rules to payloads
-	var allPayloads []HttpResponseRulePayload
-	for i, rule := range sortedRules {
-		rulePayload := r.convertToHttpResponseRulePayload(&rule, i)
-		allPayloads = append(allPayloads, *rulePayload)
-	}
-
-	// Send all rules in one request (same for both v2 and v3)
-	if err := r.client.CreateAllHttpResponseRulesInTransaction(ctx, transactionID, parentType, parentName, allPayloads); err != nil {
+	// Then recreate the merged list in one request (same for both v2 and v3)
+	if err := r.client.CreateAllHttpResponseRulesInTransaction(ctx, transactionID, parentType, parentName, merged); err != nil {
 		return fmt.Errorf("failed to create new HTTP response rules for %s %s: %w", parentType, parentName, err)
 	}
 
-	log.Printf("Updated %d HTTP response rules for %s %s in transaction %s (delete-then-create)", len(allPayloads), parentType, parentName, transactionID)
+	log.Printf("Updated %d HTTP response rules (%d managed) for %s %s in transaction %s", len(merged), len(desired), parentType, parentName, transactionID)
 	return nil
 }
 
+// convertToHttpResponseRulePayloads converts the rules of a block to payloads in processing order
+func (r *HttpResponseRuleManager) convertToHttpResponseRulePayloads(rules []haproxyHttpResponseRuleModel) []HttpResponseRulePayload {
+	sortedRules := r.processHttpResponseRulesBlock(rules)
+	payloads := make([]HttpResponseRulePayload, 0, len(sortedRules))
+	for i := range sortedRules {
+		payloads = append(payloads, *r.convertToHttpResponseRulePayload(&sortedRules[i], i))
+	}
+	return payloads
+}
+
 // hasHttpResponseRuleChanged compares two HTTP response rules to determine if they have different content
 func hasHttpResponseRuleChanged(existing, desired HttpResponseRulePayload) bool {
 	return existing.Type != desired.Type ||
@@ -1170,6 +1253,24 @@ func hasHttpResponseRuleChanged(existing, desired HttpResponseRulePayload) bool
 		existing.TrackScStickCounter != desired.TrackScStickCounter
 }
 
+// refreshHttpResponseRules returns rules as configured when HAProxy holds the same rules in the
+// same order, and the rules read from HAProxy otherwise. Comparing the payloads field by field
+// detects changed content while keeping the attributes the converters do not round-trip.
+func (r *HttpResponseRuleManager) refreshHttpResponseRules(rules []haproxyHttpResponseRuleModel, live []HttpResponseRulePayload) []haproxyHttpResponseRuleModel {
+	unchanged := itemsEqual(r.convertToHttpResponseRulePayloads(rules), live, func(a, b HttpResponseRulePayload) bool {
+		return !hasHttpResponseRuleChanged(a, b)
+	})
+	if unchanged {
+		return rules
+	}
+
+	refreshed := make([]haproxyHttpResponseRuleModel, 0, len(live))
+	for i := range live {
+		refreshed = append(refreshed, r.convertFromHttpResponseRulePayload(&live[i]))
+	}
+	return refreshed
+}
+
 // deleteAllHttpResponseRulesInTransaction deletes all HTTP response rules for a parent resource using an existing transaction ID
 func (r *HttpResponseRuleManager) deleteAllHttpResponseRulesInTransaction(ctx context.Context, transactionID string, parentType, parentName string) error {
 	rules, err := r.ReadHttpResponseRules(ctx, parentType, parentName)
@@ -0,0 +1,97 @@
+package haproxy
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// GetParentAttributes returns the id, parent_type and parent_name attributes shared by the
+// standalone resources that attach a single child object to a frontend or backend.
+func GetParentAttributes(parentTypes ...string) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:    true,
+			Description: "The identifier of the resource, in the same format used for import.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"parent_type": schema.StringAttribute{
+			Required:    true,
+			Description: fmt.Sprintf("The type of the parent section (%s).", strings.Join(parentTypes, ", ")),
+			Validators: []validator.String{
+				stringvalidator.OneOf(parentTypes...),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"parent_name": schema.StringAttribute{
+			Required:    true,
+			Description: "The name of the parent section. It may be owned by an haproxy_stack resource.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+	}
+}
+
+// parseChildImportID splits an import ID of the form parent_type/parent_name/key.
+func parseChildImportID(id string) (parentType, parentName, key string, err error) {
+	parts := strings.Split(id, "/")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return "", "", "", fmt.Errorf("import ID must be in the format: parent_type/parent_name/key (got %q)", id)
+	}
+	return parts[0], parts[1], parts[2], nil
+}
+
+// mergeManagedItems rebuilds the list held by a parent so that only the items owned by the
+// caller are replaced. Existing items matching one of managed (each managed item is matched
+// once) are dropped and desired is inserted where the first of them was; items created
+// outside the caller keep their relative order. When none of managed is found, desired is
+// appended.
+func mergeManagedItems[T any](existing, managed, desired []T, matches func(existing, managed T) bool) []T {
+	claimed := make([]bool, len(managed))
+	merged := make([]T, 0, len(existing)+len(desired))
+	inserted := false
+	for _, item := range existing {
+		owned := false
+		for j := range managed {
+			if !claimed[j] && matches(item, managed[j]) {
+				claimed[j], owned = true, true
+				break
+			}
+		}
+		if !owned {
+			merged = append(merged, item)
+			continue
+		}
+		if !inserted {
+			merged = append(merged, desired...)
+			inserted = true
+		}
+	}
+	if !inserted {
+		merged = append(merged, desired...)
+	}
+	return merged
+}
+
+// itemsEqual reports whether both lists hold equal items in the same order.
+func itemsEqual[T any](a, b []T, equal func(a, b T) bool) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !equal(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
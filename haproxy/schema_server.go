@@ -2,6 +2,7 @@ package haproxy
 
 import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // GetServerAttributes returns the common server attributes (without name field)
@@ -79,6 +80,171 @@ func GetServerAttributes() map[string]schema.Attribute {
 			Optional:    true,
 			Description: "Cookie value for the server.",
 		},
+		"maxqueue": schema.Int64Attribute{
+			Optional:    true,
+			Description: "Maximum number of connections queued for the server.",
+		},
+		"minconn": schema.Int64Attribute{
+			Optional:    true,
+			Description: "Minimum number of connections for the server when dynamic maxconn is used.",
+		},
+		"slowstart": schema.Int64Attribute{
+			Optional:    true,
+			Description: "Time in milliseconds during which the weight of a server coming back up is ramped up.",
+		},
+		"init_addr": schema.StringAttribute{
+			Optional:    true,
+			Description: "Order of the methods used to resolve the address at startup (e.g. last,libc,none).",
+		},
+		"maintenance": schema.StringAttribute{
+			Optional:    true,
+			Description: "Whether the server starts in maintenance mode (enabled or disabled).",
+		},
+		"track": schema.StringAttribute{
+			Optional:    true,
+			Description: "Server whose health check status is tracked instead of checking this server, in the form [<backend>/]<server>.",
+		},
+		"observe": schema.StringAttribute{
+			Optional:    true,
+			Description: "Traffic observed to detect server errors (layer4 or layer7).",
+		},
+		"error_limit": schema.Int64Attribute{
+			Optional:    true,
+			Description: "Number of consecutive errors that triggers on_error.",
+		},
+		"on_error": schema.StringAttribute{
+			Optional:    true,
+			Description: "Action taken when error_limit is reached (fastinter, fail-check, sudden-death or mark-down).",
+		},
+		"on_marked_down": schema.StringAttribute{
+			Optional:    true,
+			Description: "Action taken when the server is marked down (shutdown-sessions).",
+		},
+		"on_marked_up": schema.StringAttribute{
+			Optional:    true,
+			Description: "Action taken when the server is marked up (shutdown-backup-sessions).",
+		},
+		"health_check_port": schema.Int64Attribute{
+			Optional:    true,
+			Description: "Port used for health checks instead of the server port.",
+		},
+		"agent_check": schema.StringAttribute{
+			Optional:    true,
+			Description: "Whether to enable the agent check of the server (enabled or disabled). Requires agent_port.",
+		},
+		"agent_addr": schema.StringAttribute{
+			Optional:    true,
+			Description: "Address of the agent, if different from the server address.",
+		},
+		"agent_port": schema.Int64Attribute{
+			Optional:    true,
+			Description: "TCP port of the agent.",
+		},
+		"agent_inter": schema.Int64Attribute{
+			Optional:    true,
+			Description: "Interval between agent checks in milliseconds.",
+		},
+		"agent_send": schema.StringAttribute{
+			Optional:    true,
+			Description: "String sent to the agent upon connection.",
+		},
+		"proto": schema.StringAttribute{
+			Optional:    true,
+			Description: "Multiplexer protocol used to talk to the server (e.g. h2, fcgi).",
+		},
+		"alpn": schema.StringAttribute{
+			Optional:    true,
+			Description: "Comma-separated list of protocols advertised through ALPN (e.g. h2,http/1.1).",
+		},
+		"sni": schema.StringAttribute{
+			Optional:    true,
+			Description: "Sample expression used as the SNI of the TLS connection (e.g. req.hdr(host)).",
+		},
+		"verifyhost": schema.StringAttribute{
+			Optional:    true,
+			Description: "Hostname checked against the server certificate when verify is required.",
+		},
+		"ciphers": schema.StringAttribute{
+			Optional:    true,
+			Description: "TLS 1.2 and lower cipher list for the server.",
+		},
+		"ciphersuites": schema.StringAttribute{
+			Optional:    true,
+			Description: "TLS 1.3 cipher suites for the server.",
+		},
+		"ssl_reuse": schema.StringAttribute{
+			Optional:    true,
+			Description: "Whether TLS sessions are reused (enabled or disabled).",
+		},
+		"tls_tickets": schema.StringAttribute{
+			Optional:    true,
+			Description: "Whether TLS session tickets are used (enabled or disabled).",
+		},
+		"check_ssl": schema.StringAttribute{
+			Optional:    true,
+			Description: "Whether health checks are sent over TLS (enabled or disabled).",
+		},
+		"check_sni": schema.StringAttribute{
+			Optional:    true,
+			Description: "SNI sent with TLS health checks.",
+		},
+		"check_alpn": schema.StringAttribute{
+			Optional:    true,
+			Description: "Protocols advertised through ALPN with TLS health checks.",
+		},
+		"send_proxy": schema.StringAttribute{
+			Optional:    true,
+			Description: "Whether to send a PROXY protocol v1 header to the server (enabled or disabled).",
+		},
+		"send_proxy_v2": schema.StringAttribute{
+			Optional:    true,
+			Description: "Whether to send a PROXY protocol v2 header to the server (enabled or disabled).",
+		},
+		"send_proxy_v2_ssl": schema.StringAttribute{
+			Optional:    true,
+			Description: "Whether to send a PROXY protocol v2 header with the TLS information of the client connection (enabled or disabled).",
+		},
+		"send_proxy_v2_ssl_cn": schema.StringAttribute{
+			Optional:    true,
+			Description: "Whether to send a PROXY protocol v2 header with the TLS information and the client certificate CN (enabled or disabled).",
+		},
+		"proxy_v2_options": schema.ListAttribute{
+			Optional:    true,
+			ElementType: types.StringType,
+			Description: "TLVs added to the PROXY protocol v2 header (e.g. ssl, cert-cn, authority, unique-id).",
+		},
+		"pool_low_conn": schema.Int64Attribute{
+			Optional:    true,
+			Description: "Number of idle connections below which a thread does not try to reuse another thread's connection.",
+		},
+		"pool_max_conn": schema.Int64Attribute{
+			Optional:    true,
+			Description: "Maximum number of idle connections kept for the server (-1 for unlimited).",
+		},
+		"pool_purge_delay": schema.Int64Attribute{
+			Optional:    true,
+			Description: "Delay in milliseconds between two purges of idle connections.",
+		},
+		"source": schema.StringAttribute{
+			Optional:    true,
+			Description: "Source address used for connections to the server.",
+		},
+		"redir": schema.StringAttribute{
+			Optional:    true,
+			Description: "Prefix of the redirection sent to clients instead of forwarding GET and HEAD requests.",
+		},
+		"stick": schema.StringAttribute{
+			Optional:    true,
+			Description: "Whether the server is a stickiness target when it is not the first choice (enabled or disabled).",
+		},
+		"tfo": schema.StringAttribute{
+			Optional:    true,
+			Description: "Whether to use TCP Fast Open to connect to the server (enabled or disabled).",
+		},
+		"log_proto": schema.StringAttribute{
+			Optional:    true,
+			Description: "Protocol used to send logs to a log server of a ring (legacy or octet-count).",
+		},
 	}
 
 	// Add all SSL/TLS fields with version information in descriptions
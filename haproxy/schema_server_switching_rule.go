@@ -0,0 +1,165 @@
+package haproxy
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// GetServerSwitchingRuleSchema returns the schema for the server_switching_rules block
+func GetServerSwitchingRuleSchema() schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		Description: "Server switching rules (use-server). The first rule whose condition matches selects the server, bypassing load balancing; the balance algorithm is used when none matches. Rules are applied in the order they are declared.",
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"target_server": schema.StringAttribute{
+					Required:    true,
+					Description: "The name of the server to use. It must be a key of servers.",
+				},
+				"cond": schema.StringAttribute{
+					Optional:    true,
+					Description: "The condition of the rule (if, unless).",
+					Validators: []validator.String{
+						stringvalidator.OneOf("if", "unless"),
+					},
+				},
+				"cond_test": schema.StringAttribute{
+					Optional:    true,
+					Description: "The condition test of the rule, usually built from the backend ACLs (e.g. is_pinned_client).",
+				},
+			},
+		},
+	}
+}
+
+// validate checks that the target is one of the servers of the backend and that the
+// condition and its test are set together.
+func (r haproxyServerSwitchingRuleModel) validate(diags *diag.Diagnostics, rulePath path.Path, servers map[string]haproxyServerModel) {
+	if !r.TargetServer.IsNull() && !r.TargetServer.IsUnknown() {
+		if _, ok := servers[r.TargetServer.ValueString()]; !ok {
+			diags.AddAttributeError(rulePath.AtName("target_server"), "Unknown target_server",
+				fmt.Sprintf("Server %s is not defined in backend.servers.", r.TargetServer.ValueString()))
+		}
+	}
+	hasCond := !r.Cond.IsNull() && r.Cond.ValueString() != ""
+	hasCondTest := !r.CondTest.IsNull() && r.CondTest.ValueString() != ""
+	if hasCond && !hasCondTest && !r.CondTest.IsUnknown() {
+		diags.AddAttributeError(rulePath.AtName("cond_test"), "Missing cond_test",
+			"cond_test is required when cond is set.")
+	}
+	if hasCondTest && !hasCond && !r.Cond.IsUnknown() {
+		diags.AddAttributeError(rulePath.AtName("cond"), "Missing cond",
+			"cond (if or unless) is required when cond_test is set.")
+	}
+}
+
+// ServerSwitchingRuleManager manages the server switching rules of a backend
+type ServerSwitchingRuleManager struct {
+	client *HAProxyClient
+}
+
+// CreateServerSwitchingRuleManager creates a new server switching rule manager
+func CreateServerSwitchingRuleManager(client *HAProxyClient) *ServerSwitchingRuleManager {
+	return &ServerSwitchingRuleManager{
+		client: client,
+	}
+}
+
+// Create creates server switching rules
+func (r *ServerSwitchingRuleManager) Create(ctx context.Context, transactionID, backend string, rules []haproxyServerSwitchingRuleModel) error {
+	if len(rules) == 0 {
+		return nil
+	}
+
+	log.Printf("Creating %d server switching rules for backend %s", len(rules), backend)
+
+	if err := r.client.CreateAllServerSwitchingRulesInTransaction(ctx, transactionID, backend, r.convertToServerSwitchingRulePayloads(rules)); err != nil {
+		return fmt.Errorf("failed to create server switching rules for backend %s: %w", backend, err)
+	}
+
+	return nil
+}
+
+// Read reads server switching rules
+func (r *ServerSwitchingRuleManager) Read(ctx context.Context, backend string) ([]haproxyServerSwitchingRuleModel, error) {
+	payloads, err := r.client.ReadServerSwitchingRules(ctx, backend)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read server switching rules for backend %s: %w", backend, err)
+	}
+
+	sort.Slice(payloads, func(i, j int) bool {
+		return payloads[i].Index < payloads[j].Index
+	})
+
+	rules := make([]haproxyServerSwitchingRuleModel, 0, len(payloads))
+	for _, payload := range payloads {
+		rules = append(rules, r.convertFromServerSwitchingRulePayload(payload))
+	}
+	return rules, nil
+}
+
+// Update replaces the server switching rules of a backend
+func (r *ServerSwitchingRuleManager) Update(ctx context.Context, transactionID, backend string, rules []haproxyServerSwitchingRuleModel) error {
+	return r.client.replaceAllInTransaction(
+		func() error { return r.Delete(ctx, transactionID, backend) },
+		func() error {
+			if err := r.client.CreateAllServerSwitchingRulesInTransaction(ctx, transactionID, backend, r.convertToServerSwitchingRulePayloads(rules)); err != nil {
+				return fmt.Errorf("failed to replace server switching rules for backend %s: %w", backend, err)
+			}
+			return nil
+		},
+	)
+}
+
+// Delete deletes all server switching rules of a backend
+func (r *ServerSwitchingRuleManager) Delete(ctx context.Context, transactionID, backend string) error {
+	existingRules, err := r.client.ReadServerSwitchingRules(ctx, backend)
+	if err != nil {
+		return fmt.Errorf("failed to read existing server switching rules for deletion: %w", err)
+	}
+
+	// Delete in reverse order (highest index first) to avoid shifting issues
+	sort.Slice(existingRules, func(i, j int) bool {
+		return existingRules[i].Index > existingRules[j].Index
+	})
+
+	for _, rule := range existingRules {
+		if err := r.client.DeleteServerSwitchingRuleInTransaction(ctx, transactionID, rule.Index, backend); err != nil {
+			return fmt.Errorf("failed to delete server switching rule at index %d: %w", rule.Index, err)
+		}
+	}
+
+	log.Printf("Deleted %d server switching rules for backend %s", len(existingRules), backend)
+	return nil
+}
+
+// convertToServerSwitchingRulePayloads converts server switching rule blocks to payloads, indexed by position
+func (r *ServerSwitchingRuleManager) convertToServerSwitchingRulePayloads(rules []haproxyServerSwitchingRuleModel) []ServerSwitchingRulePayload {
+	payloads := make([]ServerSwitchingRulePayload, 0, len(rules))
+	for i, rule := range rules {
+		payloads = append(payloads, ServerSwitchingRulePayload{
+			Index:        int64(i),
+			TargetServer: rule.TargetServer.ValueString(),
+			Cond:         rule.Cond.ValueString(),
+			CondTest:     rule.CondTest.ValueString(),
+		})
+	}
+	return payloads
+}
+
+// convertFromServerSwitchingRulePayload converts a payload to a server switching rule block
+func (r *ServerSwitchingRuleManager) convertFromServerSwitchingRulePayload(payload ServerSwitchingRulePayload) haproxyServerSwitchingRuleModel {
+	return haproxyServerSwitchingRuleModel{
+		TargetServer: types.StringValue(payload.TargetServer),
+		Cond:         stringOrNull(payload.Cond),
+		CondTest:     stringOrNull(payload.CondTest),
+	}
+}
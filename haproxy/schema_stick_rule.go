@@ -0,0 +1,157 @@
+package haproxy
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// GetStickRuleSchema returns the schema for the stick_rules block
+func GetStickRuleSchema() schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		Description: "Stick rule configuration (stick on, stick match, stick store-request, stick store-response). Rules are applied in the order they are declared.",
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"type": schema.StringAttribute{
+					Required:    true,
+					Description: "The type of the stick rule (on, match, store-request, store-response).",
+					Validators: []validator.String{
+						stringvalidator.OneOf("on", "match", "store-request", "store-response"),
+					},
+				},
+				"pattern": schema.StringAttribute{
+					Required:    true,
+					Description: "The sample expression used as the stick table key (e.g. src).",
+				},
+				"table": schema.StringAttribute{
+					Optional:    true,
+					Description: "The name of the table to use. Defaults to the stick table of the current backend.",
+				},
+				"cond": schema.StringAttribute{
+					Optional:    true,
+					Description: "The condition of the stick rule (if, unless).",
+					Validators: []validator.String{
+						stringvalidator.OneOf("if", "unless"),
+					},
+				},
+				"cond_test": schema.StringAttribute{
+					Optional:    true,
+					Description: "The condition test of the stick rule.",
+				},
+			},
+		},
+	}
+}
+
+// StickRuleManager manages the stick rules of a backend
+type StickRuleManager struct {
+	client *HAProxyClient
+}
+
+// CreateStickRuleManager creates a new stick rule manager
+func CreateStickRuleManager(client *HAProxyClient) *StickRuleManager {
+	return &StickRuleManager{
+		client: client,
+	}
+}
+
+// Create creates stick rules
+func (r *StickRuleManager) Create(ctx context.Context, transactionID, backend string, rules []haproxyStickRuleModel) error {
+	if len(rules) == 0 {
+		return nil
+	}
+
+	log.Printf("Creating %d stick rules for backend %s", len(rules), backend)
+
+	if err := r.client.CreateAllStickRulesInTransaction(ctx, transactionID, backend, r.convertToStickRulePayloads(rules)); err != nil {
+		return fmt.Errorf("failed to create stick rules for backend %s: %w", backend, err)
+	}
+
+	return nil
+}
+
+// Read reads stick rules
+func (r *StickRuleManager) Read(ctx context.Context, backend string) ([]haproxyStickRuleModel, error) {
+	payloads, err := r.client.ReadStickRules(ctx, backend)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read stick rules for backend %s: %w", backend, err)
+	}
+
+	sort.Slice(payloads, func(i, j int) bool {
+		return payloads[i].Index < payloads[j].Index
+	})
+
+	rules := make([]haproxyStickRuleModel, 0, len(payloads))
+	for _, payload := range payloads {
+		rules = append(rules, r.convertFromStickRulePayload(payload))
+	}
+	return rules, nil
+}
+
+// Update replaces the stick rules of a backend
+func (r *StickRuleManager) Update(ctx context.Context, transactionID, backend string, rules []haproxyStickRuleModel) error {
+	return r.client.replaceAllInTransaction(
+		func() error { return r.Delete(ctx, transactionID, backend) },
+		func() error {
+			if err := r.client.CreateAllStickRulesInTransaction(ctx, transactionID, backend, r.convertToStickRulePayloads(rules)); err != nil {
+				return fmt.Errorf("failed to replace stick rules for backend %s: %w", backend, err)
+			}
+			return nil
+		},
+	)
+}
+
+// Delete deletes all stick rules of a backend
+func (r *StickRuleManager) Delete(ctx context.Context, transactionID, backend string) error {
+	existingRules, err := r.client.ReadStickRules(ctx, backend)
+	if err != nil {
+		return fmt.Errorf("failed to read existing stick rules for deletion: %w", err)
+	}
+
+	// Delete in reverse order (highest index first) to avoid shifting issues
+	sort.Slice(existingRules, func(i, j int) bool {
+		return existingRules[i].Index > existingRules[j].Index
+	})
+
+	for _, rule := range existingRules {
+		if err := r.client.DeleteStickRuleInTransaction(ctx, transactionID, rule.Index, backend); err != nil {
+			return fmt.Errorf("failed to delete stick rule at index %d: %w", rule.Index, err)
+		}
+	}
+
+	log.Printf("Deleted %d stick rules for backend %s", len(existingRules), backend)
+	return nil
+}
+
+// convertToStickRulePayloads converts stick rule blocks to payloads, indexed by position
+func (r *StickRuleManager) convertToStickRulePayloads(rules []haproxyStickRuleModel) []StickRulePayload {
+	payloads := make([]StickRulePayload, 0, len(rules))
+	for i, rule := range rules {
+		payloads = append(payloads, StickRulePayload{
+			Index:    int64(i),
+			Type:     rule.Type.ValueString(),
+			Pattern:  rule.Pattern.ValueString(),
+			Table:    rule.Table.ValueString(),
+			Cond:     rule.Cond.ValueString(),
+			CondTest: rule.CondTest.ValueString(),
+		})
+	}
+	return payloads
+}
+
+// convertFromStickRulePayload converts a payload to a stick rule block
+func (r *StickRuleManager) convertFromStickRulePayload(payload StickRulePayload) haproxyStickRuleModel {
+	return haproxyStickRuleModel{
+		Type:     types.StringValue(payload.Type),
+		Pattern:  types.StringValue(payload.Pattern),
+		Table:    stringOrNull(payload.Table),
+		Cond:     stringOrNull(payload.Cond),
+		CondTest: stringOrNull(payload.CondTest),
+	}
+}
@@ -223,15 +223,15 @@ func GetTcpRequestRuleSchema() schema.ListNestedBlock {
 				},
 				"track_sc_key": schema.StringAttribute{
 					Optional:    true,
-					Description: "The track SC key for the TCP request rule.",
+					Description: "The sample expression tracked by a track-sc action (e.g. src).",
 				},
 				"track_sc_stick_counter": schema.Int64Attribute{
 					Optional:    true,
-					Description: "The track SC stick counter for the TCP request rule.",
+					Description: "The stick counter (0-2) used by a track-sc action.",
 				},
 				"track_sc_table": schema.StringAttribute{
 					Optional:    true,
-					Description: "The track SC table for the TCP request rule.",
+					Description: "The stick table tracked into by a track-sc action, e.g. the name of an haproxy_stick_table. Defaults to the table of the current proxy.",
 				},
 				"var_expr": schema.StringAttribute{
 					Optional:    true,
@@ -1,9 +1,108 @@
 package haproxy
 
 import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync"
 	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
+// testDataPlaneAPI is a fake Data Plane API. GET requests are answered from responses, keyed by
+// path and query without the API version prefix; unknown lists read as empty. Transactions always
+// commit and every other write is recorded as "METHOD path body".
+type testDataPlaneAPI struct {
+	apiVersion string
+	responses  map[string]string
+
+	mu     sync.Mutex
+	writes []string
+}
+
+// newTestClient starts a fake Data Plane API and returns a client connected to it
+func newTestClient(t *testing.T, apiVersion string, responses map[string]string) (*HAProxyClient, *testDataPlaneAPI) {
+	t.Helper()
+
+	api := &testDataPlaneAPI{apiVersion: apiVersion, responses: responses}
+	server := httptest.NewServer(api)
+	t.Cleanup(server.Close)
+	return NewHAProxyClient(server.Client(), server.URL, "admin", "secret", apiVersion), api
+}
+
+func (a *testDataPlaneAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	path := strings.TrimPrefix(r.URL.Path, "/"+a.apiVersion)
+	query := r.URL.Query()
+	query.Del("transaction_id")
+	key := path
+	if encoded := query.Encode(); encoded != "" {
+		key += "?" + encoded
+	}
+
+	switch {
+	case path == "/services/haproxy/configuration/version":
+		_, _ = w.Write([]byte(`1`))
+	case r.Method == http.MethodPost && path == "/services/haproxy/transactions":
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"id":"test"}`))
+	case strings.HasPrefix(path, "/services/haproxy/transactions/"):
+		w.WriteHeader(http.StatusOK)
+	case r.Method == http.MethodGet:
+		body, ok := a.responses[key]
+		if !ok {
+			body = `[]`
+			if a.apiVersion == "v2" {
+				body = `{"data":[]}`
+			}
+		}
+		_, _ = w.Write([]byte(body))
+	default:
+		body, _ := io.ReadAll(r.Body)
+		a.mu.Lock()
+		a.writes = append(a.writes, strings.TrimSpace(r.Method+" "+key+" "+string(body)))
+		a.mu.Unlock()
+		switch r.Method {
+		case http.MethodPost:
+			w.WriteHeader(http.StatusCreated)
+		case http.MethodDelete:
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusOK)
+		}
+	}
+}
+
+// Writes returns the write requests received so far
+func (a *testDataPlaneAPI) Writes() []string {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return append([]string(nil), a.writes...)
+}
+
+// newTestStackOperations returns the stack operations of a stack manager using client
+func newTestStackOperations(client *HAProxyClient) *StackOperations {
+	return CreateStackManager(client, CreateACLManager(client), CreateFrontendManager(client), CreateBackendManager(client)).operations
+}
+
 // Test that the provider can be created
 func TestProviderCreation(t *testing.T) {
 	t.Parallel()
@@ -24,6 +123,1632 @@ func TestHaproxyStackResourceCreation(t *testing.T) {
 	}
 }
 
+// Test that resources have valid schemas matching their models
+func TestResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	resources := map[string]struct {
+		resource resource.Resource
+		model    interface{}
+	}{
+		"server":             {NewServerResource(), &serverResourceModel{}},
+		"acl":                {NewAclResource(), &aclResourceModel{}},
+		"bind":               {NewBindResource(), &bindResourceModel{}},
+		"http_request_rule":  {NewHttpRequestRuleResource(), &HttpRequestRuleResourceModel{}},
+		"http_response_rule": {NewHttpResponseRuleResource(), &HttpResponseRuleResourceModel{}},
+		"resolvers":          {NewResolversResource(), &resolversResourceModel{}},
+		"peers":              {NewPeersResource(), &peersResourceModel{}},
+		"global":             {NewGlobalResource(), &globalResourceModel{}},
+		"defaults":           {NewDefaultsResource(), &defaultsResourceModel{}},
+		"log_forward":        {NewLogForwardResource(), &logForwardResourceModel{}},
+		"stick_table":        {NewStickTableResource(), &stickTableResourceModel{}},
+		"userlist":           {NewUserlistResource(), &userlistResourceModel{}},
+		"cache":              {NewCacheResource(), &cacheResourceModel{}},
+		"mailers":            {NewMailersResource(), &mailersResourceModel{}},
+		"http_errors":        {NewHttpErrorsResource(), &httpErrorsResourceModel{}},
+		"ssl_certificate":    {NewSslCertificateResource(), &sslCertificateResourceModel{}},
+		"map_file":           {NewMapFileResource(), &mapFileResourceModel{}},
+		"map_entry":          {NewMapEntryResource(), &mapEntryResourceModel{}},
+		"server_state":       {NewServerStateResource(), &serverStateResourceModel{}},
+	}
+
+	ctx := context.Background()
+	for name, tc := range resources {
+		resp := &resource.SchemaResponse{}
+		tc.resource.Schema(ctx, resource.SchemaRequest{}, resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("%s: schema errors: %v", name, resp.Diagnostics)
+		}
+		if diags := resp.Schema.ValidateImplementation(ctx); diags.HasError() {
+			t.Fatalf("%s: invalid schema: %v", name, diags)
+		}
+
+		state := tfsdk.State{
+			Schema: resp.Schema,
+			Raw:    tftypes.NewValue(resp.Schema.Type().TerraformType(ctx), nil),
+		}
+		if diags := state.Set(ctx, tc.model); diags.HasError() {
+			t.Fatalf("%s: model does not match schema: %v", name, diags)
+		}
+		if diags := state.Get(ctx, tc.model); diags.HasError() {
+			t.Fatalf("%s: model does not match schema: %v", name, diags)
+		}
+	}
+}
+
+// Test that stack import IDs are split into frontend and backend names
+func TestParseStackImportID(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		id       string
+		frontend string
+		backend  string
+		wantErr  bool
+	}{
+		"frontend and backend": {id: "web/app", frontend: "web", backend: "app"},
+		"backend only":         {id: "/app", backend: "app"},
+		"frontend only":        {id: "web/", frontend: "web"},
+		"no separator":         {id: "web", wantErr: true},
+		"both sides empty":     {id: "/", wantErr: true},
+		"too many parts":       {id: "web/app/extra", wantErr: true},
+		"empty":                {id: "", wantErr: true},
+	}
+
+	for name, tc := range tests {
+		frontend, backend, err := parseStackImportID(tc.id)
+		if tc.wantErr {
+			if err == nil {
+				t.Errorf("%s: expected an error for %q", name, tc.id)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+			continue
+		}
+		if frontend != tc.frontend || backend != tc.backend {
+			t.Errorf("%s: expected %q/%q, got %q/%q", name, tc.frontend, tc.backend, frontend, backend)
+		}
+	}
+}
+
+// Test that the stack Read refreshes the backend blocks from HAProxy so drift is detected
+func TestStackReadRefreshesBackendBlocks(t *testing.T) {
+	t.Parallel()
+
+	client, _ := newTestClient(t, "v2", map[string]string{
+		"/services/haproxy/configuration/backends/app": `{"data":{"name":"app","mode":"http",` +
+			`"balance":{"algorithm":"leastconn"},` +
+			`"httpchk_params":{"method":"GET","uri":"/health"},` +
+			`"forwardfor":{"enabled":"enabled"},` +
+			`"stats_options":{"stats_uri":"/stats"},` +
+			`"stick_table":{"type":"ip","size":1000}}}`,
+	})
+
+	data := &haproxyStackResourceModel{
+		Name: types.StringValue("app"),
+		Backend: &haproxyBackendModel{
+			Name:          types.StringValue("app"),
+			Balance:       []haproxyBalanceModel{{Algorithm: types.StringValue("roundrobin"), UrlParam: types.StringNull()}},
+			HttpchkParams: []haproxyHttpchkParamsModel{{Method: types.StringValue("GET"), Uri: types.StringValue("/health"), Version: types.StringNull()}},
+			StatsOptions:  []haproxyStatsOptionsModel{{StatsEnable: types.BoolNull(), StatsUri: types.StringValue("/stats"), StatsRealm: types.StringNull(), StatsAuth: types.StringNull()}},
+			StickTable:    &haproxyStickTableModel{Type: types.StringValue("ip"), Size: types.Int64Value(1000), Expire: types.Int64Null(), Nopurge: types.BoolValue(false), Peers: types.StringNull()},
+		},
+	}
+	if err := newTestStackOperations(client).Read(context.Background(), resource.ReadRequest{}, &resource.ReadResponse{}, data); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	backend := data.Backend
+	if len(backend.Balance) != 1 || backend.Balance[0].Algorithm.ValueString() != "leastconn" {
+		t.Errorf("expected the balance algorithm changed outside of Terraform to be read, got %v", backend.Balance)
+	}
+	if len(backend.Forwardfor) != 1 || backend.Forwardfor[0].Enabled.ValueString() != "enabled" {
+		t.Errorf("expected the forwardfor block added outside of Terraform to be read, got %v", backend.Forwardfor)
+	}
+	if len(backend.HttpchkParams) != 1 || backend.HttpchkParams[0].Uri.ValueString() != "/health" {
+		t.Errorf("expected httpchk_params to be kept, got %v", backend.HttpchkParams)
+	}
+	if len(backend.StatsOptions) != 1 || !backend.StatsOptions[0].StatsEnable.IsNull() {
+		t.Errorf("expected an unset stats_enable to stay null, got %v", backend.StatsOptions)
+	}
+	if backend.StickTable == nil || backend.StickTable.Nopurge.IsNull() || backend.StickTable.Nopurge.ValueBool() {
+		t.Errorf("expected a configured false nopurge to be kept, got %v", backend.StickTable)
+	}
+}
+
+// Test that child import IDs are split into parent type, parent name and key
+func TestParseChildImportID(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		id         string
+		parentType string
+		parentName string
+		key        string
+		wantErr    bool
+	}{
+		"valid":          {id: "backend/app/web1", parentType: "backend", parentName: "app", key: "web1"},
+		"missing key":    {id: "backend/app", wantErr: true},
+		"empty part":     {id: "backend//web1", wantErr: true},
+		"too many parts": {id: "backend/app/web1/extra", wantErr: true},
+		"empty":          {id: "", wantErr: true},
+	}
+
+	for name, tc := range tests {
+		parentType, parentName, key, err := parseChildImportID(tc.id)
+		if tc.wantErr {
+			if err == nil {
+				t.Errorf("%s: expected an error for %q", name, tc.id)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+			continue
+		}
+		if parentType != tc.parentType || parentName != tc.parentName || key != tc.key {
+			t.Errorf("%s: got %s/%s/%s", name, parentType, parentName, key)
+		}
+	}
+}
+
+// Test that a stack update only rewrites its own rules and ACLs, so the ones created by the
+// standalone resources on the same parent survive
+func TestStackUpdateKeepsStandaloneRules(t *testing.T) {
+	t.Parallel()
+
+	client, api := newTestClient(t, "v3", map[string]string{
+		"/services/haproxy/configuration/frontends/web/http_request_rules": `[` +
+			`{"index":0,"type":"deny","cond":"if","cond_test":"blocked"},` +
+			`{"index":1,"type":"set-header","hdr_name":"X-Standalone","hdr_format":"1"}]`,
+		"/services/haproxy/configuration/frontends/web/acls": `[` +
+			`{"acl_name":"standalone","criterion":"src","value":"10.0.0.0/8"},` +
+			`{"acl_name":"blocked","criterion":"path_beg","value":"/admin"}]`,
+	})
+
+	state := []haproxyHttpRequestRuleModel{{Type: types.StringValue("deny"), Cond: types.StringValue("if"), CondTest: types.StringValue("blocked")}}
+	plan := []haproxyHttpRequestRuleModel{{Type: types.StringValue("deny"), Cond: types.StringValue("if"), CondTest: types.StringValue("blocked_v2")}}
+	if err := CreateHttpRequestRuleManager(client).UpdateHttpRequestRulesInTransaction(context.Background(), "test", "frontend", "web", plan, state); err != nil {
+		t.Fatalf("unexpected error updating rules: %v", err)
+	}
+
+	stateAcls := []haproxyAclModel{{AclName: types.StringValue("blocked"), Criterion: types.StringValue("path_beg"), Value: types.StringValue("/admin")}}
+	planAcls := []haproxyAclModel{{AclName: types.StringValue("blocked"), Criterion: types.StringValue("path_beg"), Value: types.StringValue("/private")}}
+	if err := CreateACLManager(client).UpdateACLsInTransaction(context.Background(), "test", "frontend", "web", planAcls, stateAcls); err != nil {
+		t.Fatalf("unexpected error updating ACLs: %v", err)
+	}
+
+	var rulesPut, aclsPut string
+	for _, write := range api.Writes() {
+		switch {
+		case strings.HasPrefix(write, "PUT /services/haproxy/configuration/frontends/web/http_request_rules "):
+			rulesPut = write
+		case strings.HasPrefix(write, "PUT /services/haproxy/configuration/frontends/web/acls "):
+			aclsPut = write
+		}
+	}
+	if !strings.Contains(rulesPut, `"cond_test":"blocked_v2"`) || !strings.Contains(rulesPut, `"hdr_name":"X-Standalone"`) ||
+		strings.Index(rulesPut, `"blocked_v2"`) > strings.Index(rulesPut, `"X-Standalone"`) {
+		t.Errorf("expected the stack rule to be replaced in place and the standalone rule kept after it, got %q", rulesPut)
+	}
+	if strings.Contains(rulesPut, `"cond_test":"blocked"`) {
+		t.Errorf("expected the old stack rule to be replaced, got %q", rulesPut)
+	}
+	if !strings.Contains(aclsPut, `"acl_name":"standalone"`) || !strings.Contains(aclsPut, `"/private"`) || strings.Contains(aclsPut, `"/admin"`) {
+		t.Errorf("expected only the stack ACL to be replaced, got %q", aclsPut)
+	}
+
+	// An unchanged list is not rewritten
+	before := len(api.Writes())
+	if err := CreateHttpRequestRuleManager(client).UpdateHttpRequestRulesInTransaction(context.Background(), "test", "frontend", "web", state, state); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if after := len(api.Writes()); after != before {
+		t.Errorf("expected no writes for unchanged rules, got %v", api.Writes()[before:])
+	}
+}
+
+// Test that resolvers are sent with their hold and timeout settings and that nameservers
+// read back keep the order of the state
+func TestResolversPayloads(t *testing.T) {
+	t.Parallel()
+
+	payload := (&resolversResource{}).toPayload(&resolversResourceModel{
+		Name:           types.StringValue("dns"),
+		HoldValid:      types.Int64Value(10000),
+		ResolveRetries: types.Int64Value(3),
+		TimeoutRetry:   types.Int64Null(),
+	})
+	if payload.Name != "dns" || payload.HoldValid != 10000 || payload.ResolveRetries != 3 || payload.TimeoutRetry != 0 {
+		t.Errorf("unexpected resolvers payload: %+v", payload)
+	}
+
+	current := []haproxyNameserverModel{
+		{Name: types.StringValue("ns2"), Address: types.StringValue("10.0.0.2"), Port: types.Int64Value(53)},
+		{Name: types.StringValue("ns1"), Address: types.StringValue("10.0.0.1"), Port: types.Int64Value(53)},
+		{Name: types.StringValue("removed"), Address: types.StringValue("10.0.0.9"), Port: types.Int64Value(53)},
+	}
+	read := []NameserverPayload{
+		{Name: "ns1", Address: "10.0.0.1", Port: 53},
+		{Name: "added", Address: "10.0.0.3"},
+		{Name: "ns2", Address: "10.0.0.20", Port: 53},
+	}
+	merged := mergeNameservers(current, read)
+
+	var names []string
+	for _, nameserver := range merged {
+		names = append(names, nameserver.Name.ValueString())
+	}
+	if strings.Join(names, ",") != "ns2,ns1,added" {
+		t.Fatalf("expected the state order followed by new nameservers, got %v", names)
+	}
+	if merged[0].Address.ValueString() != "10.0.0.20" {
+		t.Errorf("expected the address changed in HAProxy to be read, got %s", merged[0].Address)
+	}
+	if !merged[2].Port.IsNull() {
+		t.Errorf("expected a nameserver without port to have a null port, got %s", merged[2].Port)
+	}
+	if back := nameserverToPayload(merged[1]); *back != read[0] {
+		t.Errorf("expected the nameserver to round-trip, got %+v", back)
+	}
+}
+
+// Test that peer entries read back keep the order of the state and round-trip through their payloads
+func TestPeerEntriesPayloads(t *testing.T) {
+	t.Parallel()
+
+	current := []haproxyPeerEntryModel{
+		{Name: types.StringValue("lb2"), Address: types.StringValue("10.0.0.2"), Port: types.Int64Value(10000)},
+		{Name: types.StringValue("lb1"), Address: types.StringValue("10.0.0.1"), Port: types.Int64Value(10000)},
+	}
+	read := []PeerEntryPayload{
+		{Name: "lb1", Address: "10.0.0.1", Port: 10000},
+		{Name: "lb3", Address: "10.0.0.3"},
+		{Name: "lb2", Address: "10.0.0.2", Port: 10001},
+	}
+	merged := mergePeerEntries(current, read)
+
+	var names []string
+	for _, peer := range merged {
+		names = append(names, peer.Name.ValueString())
+	}
+	if strings.Join(names, ",") != "lb2,lb1,lb3" {
+		t.Fatalf("expected the state order followed by new peers, got %v", names)
+	}
+	if merged[0].Port.ValueInt64() != 10001 {
+		t.Errorf("expected the port changed in HAProxy to be read, got %s", merged[0].Port)
+	}
+	if !merged[2].Port.IsNull() {
+		t.Errorf("expected a peer without port to have a null port, got %s", merged[2].Port)
+	}
+	if back := peerEntryToPayload(merged[1]); *back != read[0] {
+		t.Errorf("expected the peer entry to round-trip, got %+v", back)
+	}
+	if len(mergePeerEntries(current, nil)) != 0 {
+		t.Errorf("expected peers removed from HAProxy to be dropped")
+	}
+}
+
+// Test that stick rules are replaced in declared order: a single PUT on v3, and on v2 the
+// existing rules are deleted from the highest index before the new ones are created
+func TestStickRuleUpdateOrdering(t *testing.T) {
+	t.Parallel()
+
+	rules := []haproxyStickRuleModel{
+		{Type: types.StringValue("match"), Pattern: types.StringValue("src"), Table: types.StringValue("sessions")},
+		{Type: types.StringValue("on"), Pattern: types.StringValue("req.cook(id)"), Cond: types.StringValue("if"), CondTest: types.StringValue("has_id")},
+	}
+
+	client, api := newTestClient(t, "v3", map[string]string{
+		"/services/haproxy/configuration/backends/app/stick_rules": `[{"index":0,"type":"on","pattern":"dst"}]`,
+	})
+	if err := CreateStickRuleManager(client).Update(context.Background(), "test", "app", rules); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []string{`PUT /services/haproxy/configuration/backends/app/stick_rules [` +
+		`{"index":0,"type":"match","pattern":"src","table":"sessions"},` +
+		`{"index":1,"type":"on","cond":"if","cond_test":"has_id","pattern":"req.cook(id)"}]`}
+	if got := api.Writes(); !reflect.DeepEqual(got, want) {
+		t.Errorf("v3: expected a single PUT of the new list\n got: %q\nwant: %q", got, want)
+	}
+
+	client, api = newTestClient(t, "v2", map[string]string{
+		"/services/haproxy/configuration/stick_rules?backend=app": `{"data":[{"index":0,"type":"on","pattern":"dst"},{"index":1,"type":"on","pattern":"src"}]}`,
+	})
+	if err := CreateStickRuleManager(client).Update(context.Background(), "test", "app", rules); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want = []string{
+		`DELETE /services/haproxy/configuration/stick_rules/1?backend=app`,
+		`DELETE /services/haproxy/configuration/stick_rules/0?backend=app`,
+		`POST /services/haproxy/configuration/stick_rules?backend=app {"index":0,"type":"match","pattern":"src","table":"sessions"}`,
+		`POST /services/haproxy/configuration/stick_rules?backend=app {"index":1,"type":"on","cond":"if","cond_test":"has_id","pattern":"req.cook(id)"}`,
+	}
+	if got := api.Writes(); !reflect.DeepEqual(got, want) {
+		t.Errorf("v2: expected the old rules to be deleted from the end before creating the new ones\n got: %q\nwant: %q", got, want)
+	}
+}
+
+// Test that the global section merge only touches the keys managed by the resource
+func TestMergeGlobalSection(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		section  map[string]interface{}
+		previous *GlobalPayload
+		desired  *GlobalPayload
+		want     map[string]interface{}
+	}{
+		"unmanaged keys are kept": {
+			section:  map[string]interface{}{"daemon": true, "maxconn": float64(1000)},
+			previous: &GlobalPayload{Maxconn: 1000},
+			desired:  &GlobalPayload{Maxconn: 2000},
+			want:     map[string]interface{}{"daemon": true, "maxconn": float64(2000)},
+		},
+		"keys removed from the configuration are deleted": {
+			section:  map[string]interface{}{"maxconn": float64(1000), "nbthread": float64(4)},
+			previous: &GlobalPayload{Maxconn: 1000, Nbthread: 4},
+			desired:  &GlobalPayload{Maxconn: 1000},
+			want:     map[string]interface{}{"maxconn": float64(1000)},
+		},
+		"nested objects are merged one level deep": {
+			section: map[string]interface{}{
+				"tune_options": map[string]interface{}{"bufsize": float64(16384), "maxrewrite": float64(1024)},
+			},
+			previous: &GlobalPayload{TuneOptions: &GlobalTuneOptions{Bufsize: 16384}},
+			desired:  &GlobalPayload{TuneOptions: &GlobalTuneOptions{HttpMaxhdr: 128}},
+			want: map[string]interface{}{
+				"tune_options": map[string]interface{}{"maxrewrite": float64(1024), "http_maxhdr": float64(128)},
+			},
+		},
+		"emptied nested objects are deleted": {
+			section: map[string]interface{}{
+				"tune_options": map[string]interface{}{"bufsize": float64(16384)},
+			},
+			previous: &GlobalPayload{TuneOptions: &GlobalTuneOptions{Bufsize: 16384}},
+			desired:  &GlobalPayload{},
+			want:     map[string]interface{}{},
+		},
+	}
+
+	for name, tc := range tests {
+		if err := mergeGlobalSection(tc.section, tc.previous, tc.desired); err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+			continue
+		}
+		if !reflect.DeepEqual(tc.section, tc.want) {
+			t.Errorf("%s: expected %v, got %v", name, tc.want, tc.section)
+		}
+	}
+}
+
+// Test that an empty haproxy_global manages nothing: Read adopts no live setting, so the plan
+// is empty and applying it leaves the runtime API and the other settings in place
+func TestGlobalEmptyConfigManagesNothing(t *testing.T) {
+	t.Parallel()
+
+	client, api := newTestClient(t, "v3", map[string]string{
+		"/services/haproxy/configuration/global": `{"daemon":true,"maxconn":2000,` +
+			`"runtime_apis":[{"address":"/var/run/haproxy.sock","level":"admin"}]}`,
+	})
+	r := &globalResource{client: client}
+
+	ctx := context.Background()
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	newState := func() tfsdk.State {
+		return tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+	}
+
+	// State as left by creating `resource "haproxy_global" "main" {}`
+	config := globalResourceModel{ID: types.StringValue(globalResourceID)}
+	req := resource.ReadRequest{State: newState()}
+	if diags := req.State.Set(ctx, &config); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	resp := &resource.ReadResponse{State: newState()}
+	r.Read(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+
+	var refreshed globalResourceModel
+	if diags := resp.State.Get(ctx, &refreshed); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if !refreshed.Maxconn.IsNull() || len(refreshed.StatsSockets) != 0 {
+		t.Fatalf("expected an empty configuration to adopt no setting, got maxconn=%s stats_socket=%v", refreshed.Maxconn, refreshed.StatsSockets)
+	}
+
+	// The plan matches the refreshed state, so applying it keeps every live setting
+	if err := r.apply(ctx, &refreshed, &config); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var section string
+	for _, write := range api.Writes() {
+		if strings.HasPrefix(write, "PUT /services/haproxy/configuration/global ") {
+			section = write
+		}
+	}
+	if !strings.Contains(section, `"maxconn":2000`) || !strings.Contains(section, `"runtime_apis"`) {
+		t.Errorf("expected the unmanaged settings to be kept, got %q", section)
+	}
+}
+
+// Test that the defaults Read detects rules whose content changed in HAProxy, keeps a configured
+// false flag, and that applying an unchanged plan does not rewrite the rules
+func TestDefaultsReadRefreshesRules(t *testing.T) {
+	t.Parallel()
+
+	client, api := newTestClient(t, "v3", map[string]string{
+		"/services/haproxy/configuration/defaults/web":                     `{"name":"web","mode":"http"}`,
+		"/services/haproxy/configuration/defaults/web/http_request_rules":  `[{"index":0,"type":"deny","cond":"if","cond_test":"blocked_v2"}]`,
+		"/services/haproxy/configuration/defaults/web/http_response_rules": `[{"index":0,"type":"set-header","hdr_name":"X-Served-By","hdr_format":"lb1"}]`,
+	})
+	r := &defaultsResource{client: client}
+
+	ctx := context.Background()
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx)
+
+	config := defaultsResourceModel{
+		ID:                types.StringValue("web"),
+		Name:              types.StringValue("web"),
+		Mode:              types.StringValue("http"),
+		Httplog:           types.BoolValue(false),
+		HttpRequestRules:  []haproxyHttpRequestRuleModel{{Type: types.StringValue("deny"), Cond: types.StringValue("if"), CondTest: types.StringValue("blocked")}},
+		HttpResponseRules: []haproxyHttpResponseRuleModel{{Type: types.StringValue("set-header"), HdrName: types.StringValue("X-Served-By"), HdrFormat: types.StringValue("lb1")}},
+	}
+	req := resource.ReadRequest{State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)}}
+	if diags := req.State.Set(ctx, &config); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	resp := &resource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)}}
+	r.Read(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+
+	var refreshed defaultsResourceModel
+	if diags := resp.State.Get(ctx, &refreshed); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if len(refreshed.HttpRequestRules) != 1 || refreshed.HttpRequestRules[0].CondTest.ValueString() != "blocked_v2" {
+		t.Errorf("expected the request rule changed in HAProxy to be read, got %v", refreshed.HttpRequestRules)
+	}
+	if !reflect.DeepEqual(refreshed.HttpResponseRules, config.HttpResponseRules) {
+		t.Errorf("expected the unchanged response rule to be kept as configured, got %v", refreshed.HttpResponseRules)
+	}
+	if refreshed.Httplog.IsNull() || refreshed.Httplog.ValueBool() {
+		t.Errorf("expected a configured false httplog to be kept, got %s", refreshed.Httplog)
+	}
+
+	updateReq := resource.UpdateRequest{
+		Plan:  tfsdk.Plan{Schema: schemaResp.Schema, Raw: resp.State.Raw},
+		State: resp.State,
+	}
+	updateResp := &resource.UpdateResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)}}
+	r.Update(ctx, updateReq, updateResp)
+	if updateResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", updateResp.Diagnostics)
+	}
+	writes := api.Writes()
+	if len(writes) == 0 || !strings.HasPrefix(writes[0], "PUT /services/haproxy/configuration/defaults/web ") {
+		t.Fatalf("expected the defaults section to be updated, got %q", writes)
+	}
+	for _, write := range writes[1:] {
+		if strings.Contains(write, "_rules") || strings.Contains(write, "log_targets") {
+			t.Errorf("expected unchanged lists not to be rewritten, got %q", write)
+		}
+	}
+}
+
+// Test that log targets round-trip through their payloads in order, and that log forward
+// listeners read back keep the order of the state
+func TestLogForwardConversions(t *testing.T) {
+	t.Parallel()
+
+	logTargets := []haproxyLogTargetModel{
+		{Address: types.StringValue("127.0.0.1:514"), Facility: types.StringValue("local0"), Level: types.StringNull(), Minlevel: types.StringNull(), Format: types.StringNull(), Length: types.Int64Null()},
+		{Address: types.StringValue("10.0.0.5:514"), Facility: types.StringValue("local1"), Level: types.StringValue("info"), Minlevel: types.StringNull(), Format: types.StringValue("rfc5424"), Length: types.Int64Value(2048)},
+	}
+	payloads := logTargetsToPayloads(logTargets)
+	for i, payload := range payloads {
+		if payload.Index != int64(i) {
+			t.Errorf("log target %d: expected index %d, got %d", i, i, payload.Index)
+		}
+	}
+	if got := logTargetsFromPayloads(payloads); !reflect.DeepEqual(got, logTargets) {
+		t.Errorf("log targets: expected %v, got %v", logTargets, got)
+	}
+
+	port := int64(5140)
+	current := []haproxyLogForwardBindModel{
+		logForwardBindModel("udp", "0.0.0.0", &port),
+		logForwardBindModel("removed", "0.0.0.0", &port),
+	}
+	read := []haproxyLogForwardBindModel{
+		logForwardBindModel("added", "::", nil),
+		logForwardBindModel("udp", "127.0.0.1", &port),
+	}
+	merged := mergeLogForwardBinds(current, read)
+	if len(merged) != 2 || merged[0].Name.ValueString() != "udp" || merged[1].Name.ValueString() != "added" {
+		t.Fatalf("expected the state order followed by new listeners, got %v", merged)
+	}
+	if merged[0].Address.ValueString() != "127.0.0.1" || !merged[1].Port.IsNull() {
+		t.Errorf("expected the listeners to be read from HAProxy, got %v", merged)
+	}
+}
+
+// Test that the stick table Read picks up changed settings while keeping a configured false
+// nopurge, and that stack TCP request rules send their track-sc fields
+func TestStickTableReadAndTrackSc(t *testing.T) {
+	t.Parallel()
+
+	client, _ := newTestClient(t, "v3", map[string]string{
+		"/services/haproxy/configuration/backends/sessions": `{"name":"sessions","stick_table":{"type":"ip","size":200000,"expire":60000}}`,
+	})
+	r := &stickTableResource{client: client}
+
+	ctx := context.Background()
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx)
+
+	config := stickTableResourceModel{
+		ID:      types.StringValue("sessions"),
+		Name:    types.StringValue("sessions"),
+		Type:    types.StringValue("ip"),
+		Size:    types.Int64Value(100000),
+		Expire:  types.Int64Value(60000),
+		Nopurge: types.BoolValue(false),
+	}
+	req := resource.ReadRequest{State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)}}
+	if diags := req.State.Set(ctx, &config); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	resp := &resource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)}}
+	r.Read(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+
+	var refreshed stickTableResourceModel
+	if diags := resp.State.Get(ctx, &refreshed); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if refreshed.Size.ValueInt64() != 200000 {
+		t.Errorf("expected the size changed in HAProxy to be read, got %s", refreshed.Size)
+	}
+	if refreshed.Nopurge.IsNull() || refreshed.Nopurge.ValueBool() {
+		t.Errorf("expected a configured false nopurge to be kept, got %s", refreshed.Nopurge)
+	}
+	if payload := r.toPayload(&refreshed); payload.StickTable == nil || payload.StickTable.Type != "ip" || payload.StickTable.Expire != 60000 {
+		t.Errorf("unexpected stick table payload: %+v", payload.StickTable)
+	}
+
+	rules := newTestStackOperations(client).convertTcpRequestRulesToResourceModels([]haproxyTcpRequestRuleModel{{
+		Type:                types.StringValue("connection"),
+		Action:              types.StringValue("track-sc0"),
+		TrackScKey:          types.StringValue("src"),
+		TrackScStickCounter: types.Int64Value(0),
+		TrackScTable:        types.StringValue("sessions"),
+	}}, "frontend", "web")
+	payload := CreateTcpRequestRuleManager(client).convertToTcpRequestRulePayload(&rules[0], 0)
+	if payload.TrackKey != "src" || payload.TrackTable != "sessions" {
+		t.Errorf("expected the track-sc fields to be sent, got %+v", payload)
+	}
+}
+
+// Test that user passwords are sent hashed or write-only and that plain-text passwords are
+// never read back into the state
+func TestUserlistPasswords(t *testing.T) {
+	t.Parallel()
+
+	users := []haproxyUserlistUserModel{
+		{Username: types.StringValue("admin"), PasswordWO: types.StringNull(), PasswordWOVersion: types.Int64Null(), PasswordHash: types.StringValue("$5$salt$hash")},
+		{Username: types.StringValue("ops"), PasswordWO: types.StringValue("s3cret"), PasswordWOVersion: types.Int64Value(2), PasswordHash: types.StringNull()},
+	}
+	passwords := writeOnlyPasswords(users)
+
+	if payload := userToPayload(users[0], passwords); payload.Password != "$5$salt$hash" || !payload.SecurePassword {
+		t.Errorf("expected password_hash to be sent as a secure password, got %+v", payload)
+	}
+	if payload := userToPayload(users[1], passwords); payload.Password != "s3cret" || payload.SecurePassword {
+		t.Errorf("expected password_wo to be sent as an insecure password, got %+v", payload)
+	}
+	// The plan never holds the write-only value
+	planned := users[1]
+	planned.PasswordWO = types.StringNull()
+	if payload := userToPayload(planned, passwords); payload.Password != "s3cret" {
+		t.Errorf("expected the password to be taken from the configuration, got %+v", payload)
+	}
+
+	read := []UserPayload{
+		{Username: "ops", Password: "s3cret"},
+		{Username: "admin", Password: "$5$salt$hash", SecurePassword: true},
+	}
+	merged := mergeUserlistUsers(users, read)
+	if len(merged) != 2 || merged[0].Username.ValueString() != "admin" {
+		t.Fatalf("expected the state order to be kept, got %v", merged)
+	}
+	if merged[0].PasswordHash.ValueString() != "$5$salt$hash" {
+		t.Errorf("expected password_hash to be read back, got %s", merged[0].PasswordHash)
+	}
+	if !merged[1].PasswordWO.IsNull() || !merged[1].PasswordHash.IsNull() {
+		t.Errorf("expected the plain-text password not to be read back, got %v", merged[1])
+	}
+	if merged[1].PasswordWOVersion.ValueInt64() != 2 {
+		t.Errorf("expected password_wo_version to be kept from the state, got %s", merged[1].PasswordWOVersion)
+	}
+}
+
+// Test that cache-use and cache-store rules are validated on the right rule list, that unknown
+// values are left for apply time, and that the cache name and settings are sent
+func TestCacheRules(t *testing.T) {
+	t.Parallel()
+
+	rulePath := path.Root("frontend").AtName("http_request_rules").AtListIndex(0)
+	requestRules := map[string]struct {
+		rule    haproxyHttpRequestRuleModel
+		wantErr bool
+	}{
+		"cache-use with a cache":      {rule: haproxyHttpRequestRuleModel{Type: types.StringValue("cache-use"), CacheName: types.StringValue("static")}},
+		"cache-use without a cache":   {rule: haproxyHttpRequestRuleModel{Type: types.StringValue("cache-use"), CacheName: types.StringNull()}, wantErr: true},
+		"cache-use with unknown name": {rule: haproxyHttpRequestRuleModel{Type: types.StringValue("cache-use"), CacheName: types.StringUnknown()}},
+		"cache-store on a request":    {rule: haproxyHttpRequestRuleModel{Type: types.StringValue("cache-store"), CacheName: types.StringValue("static")}, wantErr: true},
+		"cache name on another type":  {rule: haproxyHttpRequestRuleModel{Type: types.StringValue("deny"), CacheName: types.StringValue("static")}, wantErr: true},
+		"unknown type":                {rule: haproxyHttpRequestRuleModel{Type: types.StringUnknown(), CacheName: types.StringValue("static")}},
+	}
+	for name, tc := range requestRules {
+		var diags diag.Diagnostics
+		tc.rule.validateCacheAction(&diags, rulePath)
+		if diags.HasError() != tc.wantErr {
+			t.Errorf("request %s: expected error %t, got %v", name, tc.wantErr, diags)
+		}
+	}
+
+	responseRules := map[string]struct {
+		rule    haproxyHttpResponseRuleModel
+		wantErr bool
+	}{
+		"cache-store with a cache":    {rule: haproxyHttpResponseRuleModel{Type: types.StringValue("cache-store"), CacheName: types.StringValue("static")}},
+		"cache-store without a cache": {rule: haproxyHttpResponseRuleModel{Type: types.StringValue("cache-store"), CacheName: types.StringNull()}, wantErr: true},
+		"cache-use on a response":     {rule: haproxyHttpResponseRuleModel{Type: types.StringValue("cache-use"), CacheName: types.StringValue("static")}, wantErr: true},
+		"unknown type":                {rule: haproxyHttpResponseRuleModel{Type: types.StringUnknown(), CacheName: types.StringNull()}},
+	}
+	for name, tc := range responseRules {
+		var diags diag.Diagnostics
+		tc.rule.validateCacheAction(&diags, rulePath)
+		if diags.HasError() != tc.wantErr {
+			t.Errorf("response %s: expected error %t, got %v", name, tc.wantErr, diags)
+		}
+	}
+
+	payload := CreateHttpRequestRuleManager(nil).convertToHttpRequestRulePayload(&haproxyHttpRequestRuleModel{Type: types.StringValue("cache-use"), CacheName: types.StringValue("static")}, 0)
+	if payload.Type != "cache-use" || payload.CacheName != "static" {
+		t.Errorf("expected the cache name to be sent, got %+v", payload)
+	}
+
+	cache := (&cacheResource{}).toPayload(&cacheResourceModel{
+		Name:          types.StringValue("static"),
+		TotalMaxSize:  types.Int64Value(64),
+		MaxAge:        types.Int64Value(60),
+		MaxObjectSize: types.Int64Null(),
+		ProcessVary:   types.BoolValue(false),
+	})
+	if cache.Name != "static" || cache.TotalMaxSize != 64 || cache.MaxAge != 60 || cache.MaxObjectSize != 0 {
+		t.Errorf("unexpected cache payload: %+v", cache)
+	}
+	if cache.ProcessVary == nil || *cache.ProcessVary {
+		t.Errorf("expected a configured false process_vary to be sent, got %v", cache.ProcessVary)
+	}
+}
+
+// Test that mailer entries round-trip through their payloads, keep the state order on Read,
+// and that a backend email_alert block is sent with its mailers section
+func TestMailersConversions(t *testing.T) {
+	t.Parallel()
+
+	mailer := haproxyMailerEntryModel{Name: types.StringValue("smtp1"), Address: types.StringValue("10.0.0.25"), Port: types.Int64Value(587)}
+	if got := mailerEntryFromPayload(*mailerEntryToPayload(mailer)); !reflect.DeepEqual(got, mailer) {
+		t.Errorf("mailer entry: expected %v, got %v", mailer, got)
+	}
+
+	current := []haproxyMailerEntryModel{
+		{Name: types.StringValue("smtp2")},
+		{Name: types.StringValue("removed")},
+		{Name: types.StringValue("smtp1")},
+	}
+	read := []MailerEntryPayload{
+		{Name: "smtp1", Address: "10.0.0.25", Port: 587},
+		{Name: "added", Address: "10.0.0.27", Port: 25},
+		{Name: "smtp2", Address: "10.0.0.26", Port: 25},
+	}
+	merged := mergeMailerEntries(current, read)
+	var names []string
+	for _, entry := range merged {
+		names = append(names, entry.Name.ValueString())
+	}
+	if expected := []string{"smtp2", "smtp1", "added"}; !reflect.DeepEqual(names, expected) {
+		t.Errorf("expected mailer order %v, got %v", expected, names)
+	}
+
+	r := &BackendManager{}
+	if r.processEmailAlertBlock(nil) != nil {
+		t.Errorf("expected no email alert payload without an email_alert block")
+	}
+	payload := r.processEmailAlertBlock(&haproxyEmailAlertModel{
+		From:       types.StringValue("haproxy@example.com"),
+		To:         types.StringValue("ops@example.com"),
+		Level:      types.StringValue("alert"),
+		Mailers:    types.StringValue("alerting"),
+		Myhostname: types.StringNull(),
+	})
+	expected := &EmailAlertPayload{From: "haproxy@example.com", To: "ops@example.com", Level: "alert", Mailers: "alerting"}
+	if !reflect.DeepEqual(payload, expected) {
+		t.Errorf("email alert: expected %+v, got %+v", expected, payload)
+	}
+}
+
+// Test that error pages set exactly one of content and http_errors
+func TestErrorFilesValidate(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		errorFile haproxyErrorFilesModel
+		wantErr   bool
+	}{
+		"content": {
+			errorFile: haproxyErrorFilesModel{Code: types.Int64Value(503), Content: types.StringValue("down"), HttpErrors: types.StringNull()},
+		},
+		"http_errors": {
+			errorFile: haproxyErrorFilesModel{Code: types.Int64Value(503), Content: types.StringNull(), HttpErrors: types.StringValue("site")},
+		},
+		"both": {
+			errorFile: haproxyErrorFilesModel{Code: types.Int64Value(503), Content: types.StringValue("down"), HttpErrors: types.StringValue("site")},
+			wantErr:   true,
+		},
+		"neither": {
+			errorFile: haproxyErrorFilesModel{Code: types.Int64Value(503), Content: types.StringNull(), HttpErrors: types.StringNull()},
+			wantErr:   true,
+		},
+		"content not known until apply": {
+			errorFile: haproxyErrorFilesModel{Code: types.Int64Value(503), Content: types.StringUnknown(), HttpErrors: types.StringNull()},
+		},
+	}
+
+	for name, tc := range tests {
+		var diags diag.Diagnostics
+		tc.errorFile.validate(&diags, path.Root("error_files").AtListIndex(0))
+		if diags.HasError() != tc.wantErr {
+			t.Errorf("%s: expected error %t, got %v", name, tc.wantErr, diags)
+		}
+	}
+}
+
+// Test that a stored certificate exposes its storage path and the metadata parsed from its
+// first certificate, skipping a leading private key
+func TestSslCertificateMetadata(t *testing.T) {
+	t.Parallel()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	notAfter := time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "www.example.com"},
+		DNSNames:     []string{"www.example.com", "example.com"},
+		IPAddresses:  []net.IP{net.ParseIP("10.0.0.1")},
+		NotBefore:    notAfter.AddDate(-1, 0, 0),
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	content := string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer})) +
+		string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+
+	if _, err := parseCertificate("not a certificate"); err == nil {
+		t.Errorf("expected an error for content without a certificate")
+	}
+
+	client, _ := newTestClient(t, "v3", map[string]string{
+		"/services/haproxy/storage/ssl_certificates/site.pem": `{"storage_name":"site.pem","file":"/etc/haproxy/ssl/site.pem"}`,
+	})
+	r := &sslCertificateResource{client: client}
+	model := sslCertificateResourceModel{Name: types.StringValue("site.pem"), Content: types.StringValue(content)}
+	r.setComputed(context.Background(), &model, &SslCertificatePayload{StorageName: "site.pem"})
+
+	if model.File.ValueString() != "/etc/haproxy/ssl/site.pem" {
+		t.Errorf("expected the stored path to be read back, got %s", model.File)
+	}
+	if model.Subject.ValueString() != "CN=www.example.com" {
+		t.Errorf("expected subject CN=www.example.com, got %s", model.Subject)
+	}
+	if model.NotAfter.ValueString() != "2030-01-02T03:04:05Z" {
+		t.Errorf("expected not_after 2030-01-02T03:04:05Z, got %s", model.NotAfter)
+	}
+	expected := []types.String{types.StringValue("www.example.com"), types.StringValue("example.com"), types.StringValue("10.0.0.1")}
+	if !reflect.DeepEqual(model.Sans, expected) {
+		t.Errorf("expected sans %v, got %v", expected, model.Sans)
+	}
+}
+
+// Test that set-var rules name their variable and take exactly one value source, and that map
+// entries are written to the running process with force_sync on both API versions
+func TestSetVarRulesAndMapEntries(t *testing.T) {
+	t.Parallel()
+
+	rulePath := path.Root("frontend").AtName("http_request_rules").AtListIndex(0)
+	setVar := func(name, scope, expr, format types.String) haproxyHttpRequestRuleModel {
+		return haproxyHttpRequestRuleModel{Type: types.StringValue("set-var"), VarName: name, VarScope: scope, VarExpr: expr, VarFormat: format}
+	}
+	tests := map[string]struct {
+		rule    haproxyHttpRequestRuleModel
+		wantErr bool
+	}{
+		"expression":           {rule: setVar(types.StringValue("backend"), types.StringValue("txn"), types.StringValue("req.hdr(host)"), types.StringNull())},
+		"format":               {rule: setVar(types.StringValue("backend"), types.StringValue("txn"), types.StringNull(), types.StringValue("%[src]"))},
+		"missing name":         {rule: setVar(types.StringNull(), types.StringValue("txn"), types.StringValue("src"), types.StringNull()), wantErr: true},
+		"missing scope":        {rule: setVar(types.StringValue("backend"), types.StringNull(), types.StringValue("src"), types.StringNull()), wantErr: true},
+		"both sources":         {rule: setVar(types.StringValue("backend"), types.StringValue("txn"), types.StringValue("src"), types.StringValue("%[src]")), wantErr: true},
+		"no source":            {rule: setVar(types.StringValue("backend"), types.StringValue("txn"), types.StringNull(), types.StringNull()), wantErr: true},
+		"name unknown":         {rule: setVar(types.StringUnknown(), types.StringValue("txn"), types.StringValue("src"), types.StringNull())},
+		"source unknown":       {rule: setVar(types.StringValue("backend"), types.StringValue("txn"), types.StringUnknown(), types.StringNull())},
+		"type unknown":         {rule: haproxyHttpRequestRuleModel{Type: types.StringUnknown(), VarName: types.StringNull(), VarScope: types.StringNull(), VarExpr: types.StringNull(), VarFormat: types.StringNull()}},
+		"fields on other rule": {rule: haproxyHttpRequestRuleModel{Type: types.StringValue("deny"), VarName: types.StringNull(), VarScope: types.StringNull(), VarExpr: types.StringNull(), VarFormat: types.StringNull()}},
+	}
+	for name, tc := range tests {
+		var diags diag.Diagnostics
+		tc.rule.validateSetVarAction(&diags, rulePath)
+		if diags.HasError() != tc.wantErr {
+			t.Errorf("%s: expected error %t, got %v", name, tc.wantErr, diags)
+		}
+	}
+
+	rule := setVar(types.StringValue("backend"), types.StringValue("txn"), types.StringValue("req.hdr(host)"), types.StringNull())
+	payload := CreateHttpRequestRuleManager(nil).convertToHttpRequestRulePayload(&rule, 0)
+	if payload.VarName != "backend" || payload.VarScope != "txn" || payload.VarExpr != "req.hdr(host)" || payload.VarFormat != "" {
+		t.Errorf("expected the set-var fields to be sent, got %+v", payload)
+	}
+
+	expected := map[string][]string{
+		"v3": {
+			`POST /services/haproxy/runtime/maps/hosts.map/entries?force_sync=true {"key":"example.com","value":"web"}`,
+			`PUT /services/haproxy/runtime/maps/hosts.map/entries/example.com?force_sync=true {"value":"api"}`,
+		},
+		"v2": {
+			`POST /services/haproxy/runtime/maps_entries?force_sync=true&map=hosts.map {"key":"example.com","value":"web"}`,
+			`PUT /services/haproxy/runtime/maps_entries/example.com?force_sync=true&map=hosts.map {"value":"api"}`,
+		},
+	}
+	for apiVersion, writes := range expected {
+		client, api := newTestClient(t, apiVersion, nil)
+		ctx := context.Background()
+		if err := client.CreateMapEntry(ctx, "hosts.map", &MapEntryPayload{Key: "example.com", Value: "web"}); err != nil {
+			t.Fatalf("%s: unexpected error: %v", apiVersion, err)
+		}
+		if err := client.UpdateMapEntry(ctx, "hosts.map", &MapEntryPayload{Key: "example.com", Value: "api"}); err != nil {
+			t.Fatalf("%s: unexpected error: %v", apiVersion, err)
+		}
+		if got := api.Writes(); !reflect.DeepEqual(got, writes) {
+			t.Errorf("%s: expected writes %v, got %v", apiVersion, writes, got)
+		}
+	}
+}
+
+// Test that a server state is set through the runtime servers endpoint of each API version,
+// sending the weight only when it is managed
+func TestServerStateApply(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		serverURL string
+		weight    types.Int64
+		write     string
+	}{
+		"v3": {
+			serverURL: "/services/haproxy/runtime/backends/web/servers/web1",
+			weight:    types.Int64Value(50),
+			write:     `PUT /services/haproxy/runtime/backends/web/servers/web1 {"admin_state":"drain","weight":50}`,
+		},
+		"v2": {
+			serverURL: "/services/haproxy/runtime/servers/web1?backend=web",
+			weight:    types.Int64Null(),
+			write:     `PUT /services/haproxy/runtime/servers/web1?backend=web {"admin_state":"drain"}`,
+		},
+	}
+	for apiVersion, tc := range tests {
+		client, api := newTestClient(t, apiVersion, map[string]string{
+			tc.serverURL: `{"name":"web1","admin_state":"drain","operational_state":"up"}`,
+		})
+		r := &serverStateResource{client: client}
+		plan := serverStateResourceModel{
+			ParentType: types.StringValue("backend"),
+			ParentName: types.StringValue("web"),
+			Name:       types.StringValue("web1"),
+			AdminState: types.StringValue("drain"),
+			Weight:     tc.weight,
+		}
+		server, err := r.apply(context.Background(), &plan)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", apiVersion, err)
+		}
+		if server.OperationalState != "up" {
+			t.Errorf("%s: expected the server state to be read back, got %+v", apiVersion, server)
+		}
+		if writes := api.Writes(); len(writes) != 1 || writes[0] != tc.write {
+			t.Errorf("%s: expected write %q, got %v", apiVersion, tc.write, writes)
+		}
+	}
+}
+
+// Test that wait_for_healthy needs enough servers, returns once min_healthy servers are UP,
+// and reports every server that is not UP when the timeout expires
+func TestWaitForHealthy(t *testing.T) {
+	t.Parallel()
+
+	stack := func(minHealthy int64, servers ...string) *haproxyStackResourceModel {
+		backend := &haproxyBackendModel{Name: types.StringValue("web"), Servers: map[string]haproxyServerModel{}}
+		for _, name := range servers {
+			backend.Servers[name] = haproxyServerModel{}
+		}
+		return &haproxyStackResourceModel{
+			Backend:        backend,
+			WaitForHealthy: &haproxyWaitForHealthyModel{MinHealthy: types.Int64Value(minHealthy), Timeout: types.Int64Value(1)},
+		}
+	}
+
+	var diags diag.Diagnostics
+	validateStackWaitForHealthy(&diags, stack(3, "web1", "web2"))
+	if !diags.HasError() {
+		t.Errorf("expected an error for min_healthy above the number of servers")
+	}
+	diags = nil
+	validateStackWaitForHealthy(&diags, stack(1))
+	if !diags.HasError() {
+		t.Errorf("expected an error for a backend without servers")
+	}
+
+	client, _ := newTestClient(t, "v3", map[string]string{
+		"/services/haproxy/runtime/backends/web/servers": `[{"name":"web1","admin_state":"ready","operational_state":"up"},{"name":"web2","admin_state":"ready","operational_state":"down"}]`,
+	})
+	o := newTestStackOperations(client)
+
+	diags = nil
+	o.WaitForHealthy(context.Background(), stack(1, "web1", "web2", "web3"), &diags)
+	if diags.HasError() {
+		t.Errorf("expected one UP server to meet min_healthy 1, got %v", diags)
+	}
+
+	diags = nil
+	o.WaitForHealthy(context.Background(), stack(2, "web1", "web2", "web3"), &diags)
+	if diags.ErrorsCount() != 2 {
+		t.Fatalf("expected an error for web2 and web3, got %v", diags)
+	}
+	if !strings.Contains(diags[0].Detail(), "web2 of backend web is down") || !strings.Contains(diags[1].Detail(), "web3 of backend web is not in the running process") {
+		t.Errorf("unexpected server diagnostics: %v", diags)
+	}
+}
+
+// Test that drain_before_delete drains the servers removed from the stack backend, leaving
+// kept servers and servers already out of rotation alone
+func TestDrainRemovedServers(t *testing.T) {
+	t.Parallel()
+
+	client, api := newTestClient(t, "v3", map[string]string{
+		"/services/haproxy/runtime/backends/web/servers/web2":             `{"name":"web2","admin_state":"ready","operational_state":"up"}`,
+		"/services/haproxy/runtime/backends/web/servers/web3":             `{"name":"web3","admin_state":"maint","operational_state":"down"}`,
+		"/services/haproxy/stats/native?name=web2&parent=web&type=server": `{"stats":[{"type":"server","name":"web2","backend_name":"web","stats":{"scur":0}}]}`,
+		"/services/haproxy/stats/native?name=web3&parent=web&type=server": `{"stats":[{"type":"server","name":"web3","backend_name":"web","stats":{"scur":0}}]}`,
+	})
+
+	ctx := context.Background()
+	schemaResp := &resource.SchemaResponse{}
+	NewHaproxyStackResource().Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	state := haproxyStackResourceModel{
+		Name: types.StringValue("app"),
+		Backend: &haproxyBackendModel{
+			Name:    types.StringValue("web"),
+			Servers: map[string]haproxyServerModel{"web1": {}, "web2": {}, "web3": {}},
+		},
+	}
+	req := resource.UpdateRequest{State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}}
+	if diags := req.State.Set(ctx, &state); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	plan := &haproxyStackResourceModel{
+		Name: types.StringValue("app"),
+		Backend: &haproxyBackendModel{
+			Name:              types.StringValue("web"),
+			Servers:           map[string]haproxyServerModel{"web1": {}},
+			DrainBeforeDelete: &haproxyDrainBeforeDeleteModel{Timeout: types.Int64Value(1)},
+		},
+	}
+	if err := newTestStackOperations(client).drainRemovedServers(ctx, req, plan); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := []string{`PUT /services/haproxy/runtime/backends/web/servers/web2 {"admin_state":"drain"}`}
+	if writes := api.Writes(); !reflect.DeepEqual(writes, expected) {
+		t.Errorf("expected writes %v, got %v", expected, writes)
+	}
+
+	// Without drain_before_delete removed servers are deleted straight away
+	plan.Backend.DrainBeforeDelete = nil
+	if err := newTestStackOperations(client).drainRemovedServers(ctx, req, plan); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if writes := api.Writes(); len(writes) != 1 {
+		t.Errorf("expected no drain without drain_before_delete, got %v", writes)
+	}
+}
+
+// Test that the stats data source reads the per-socket collections of v2, filters out lines
+// the API did not filter, and leaves counters HAProxy omits null
+func TestStatsDataSourceRead(t *testing.T) {
+	t.Parallel()
+
+	client, _ := newTestClient(t, "v2", map[string]string{
+		"/services/haproxy/stats/native?parent=web&type=server": `[` +
+			`{"runtimeAPI":"/var/run/haproxy1.sock","stats":[` +
+			`{"type":"server","name":"web1","backend_name":"web","stats":{"status":"UP","check_status":"L7OK","scur":3,"rate":12}},` +
+			`{"type":"server","name":"api1","backend_name":"api","stats":{"status":"UP","scur":1}}]},` +
+			`{"runtimeAPI":"/var/run/haproxy2.sock","stats":[` +
+			`{"type":"backend","name":"web","stats":{"status":"UP","scur":5}},` +
+			`{"type":"server","name":"web2","backend_name":"web","stats":{"status":"DOWN","check_status":"L4CON"}}]}]`,
+	})
+	d := &statsDataSource{client: client}
+
+	ctx := context.Background()
+	schemaResp := &datasource.SchemaResponse{}
+	d.Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx)
+
+	config := statsDataSourceModel{
+		ID:     types.StringNull(),
+		Type:   types.StringValue("server"),
+		Name:   types.StringNull(),
+		Parent: types.StringValue("web"),
+	}
+	// tfsdk.Config cannot be set from a model, so the configuration is encoded through a state
+	encoded := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)}
+	if diags := encoded.Set(ctx, &config); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	req := datasource.ReadRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: encoded.Raw}}
+	resp := &datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)}}
+	d.Read(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+
+	var data statsDataSourceModel
+	if diags := resp.State.Get(ctx, &data); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if data.ID.ValueString() != "server/web/" {
+		t.Errorf("expected id server/web/, got %s", data.ID)
+	}
+	if len(data.Stats) != 2 || data.Stats[0].Name.ValueString() != "web1" || data.Stats[1].Name.ValueString() != "web2" {
+		t.Fatalf("expected the servers of backend web, got %v", data.Stats)
+	}
+	web1, web2 := data.Stats[0], data.Stats[1]
+	if web1.Scur.ValueInt64() != 3 || web1.Rate.ValueInt64() != 12 || web1.CheckStatus.ValueString() != "L7OK" {
+		t.Errorf("unexpected stats for web1: %+v", web1)
+	}
+	if web2.Status.ValueString() != "DOWN" || !web2.Scur.IsNull() || !web2.Weight.IsNull() {
+		t.Errorf("expected omitted counters of web2 to be null, got %+v", web2)
+	}
+}
+
+// Test that the API version is detected from the /info endpoints the Data Plane API serves
+func TestDetectAPIVersion(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		served    map[string]int
+		password  string
+		requested string
+		want      string
+		wantErr   string
+	}{
+		"v3 is preferred": {
+			served: map[string]int{"/v3/info": http.StatusOK, "/v2/info": http.StatusOK},
+			want:   "v3",
+		},
+		"falls back to v2": {
+			served: map[string]int{"/v3/info": http.StatusNotFound, "/v2/info": http.StatusOK},
+			want:   "v2",
+		},
+		"requested version is used": {
+			served:    map[string]int{"/v3/info": http.StatusOK, "/v2/info": http.StatusOK},
+			requested: "v2",
+			want:      "v2",
+		},
+		"requested version not served": {
+			served:    map[string]int{"/v3/info": http.StatusNotFound, "/v2/info": http.StatusOK},
+			requested: "v3",
+			wantErr:   "does not serve api_version v3",
+		},
+		"neither version served": {
+			served:  map[string]int{"/v3/info": http.StatusNotFound, "/v2/info": http.StatusNotFound},
+			wantErr: "serves neither v3 nor v2",
+		},
+		"bad credentials": {
+			served:   map[string]int{"/v3/info": http.StatusOK, "/v2/info": http.StatusOK},
+			password: "wrong",
+			wantErr:  "rejected the credentials",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if username, password, ok := r.BasicAuth(); !ok || username != "admin" || password != "secret" {
+					w.WriteHeader(http.StatusUnauthorized)
+					return
+				}
+				status, ok := tc.served[r.URL.Path]
+				if !ok {
+					status = http.StatusNotFound
+				}
+				w.WriteHeader(status)
+				if status == http.StatusOK {
+					_, _ = w.Write([]byte(`{"api":{"version":"test"}}`))
+				}
+			}))
+			defer server.Close()
+
+			password := tc.password
+			if password == "" {
+				password = "secret"
+			}
+			got, err := detectAPIVersion(context.Background(), server.Client(), server.URL, "admin", password, tc.requested)
+			if tc.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+					t.Fatalf("expected error containing %q, got %v", tc.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tc.want {
+				t.Fatalf("expected %s, got %s", tc.want, got)
+			}
+		})
+	}
+}
+
+// Test that server options are checked against the protocol fields of the API version, and
+// that the options shared by both versions are checked in each of them
+func TestServerOptionsValidate(t *testing.T) {
+	t.Parallel()
+
+	enabled, disabled := types.StringValue("enabled"), types.StringValue("disabled")
+	tests := map[string]struct {
+		apiVersion string
+		server     haproxyServerModel
+		wantErr    bool
+		wantWarn   bool
+	}{
+		"v2 ciphersuites": {
+			apiVersion: "v2",
+			server:     haproxyServerModel{Ciphersuites: types.StringValue("TLS_AES_128_GCM_SHA256")},
+		},
+		"v2 ciphersuites without TLSv1.3": {
+			apiVersion: "v2",
+			server:     haproxyServerModel{Ciphersuites: types.StringValue("TLS_AES_128_GCM_SHA256"), NoTlsv13: enabled},
+			wantWarn:   true,
+		},
+		"v2 ciphersuites forced to TLSv1.2": {
+			apiVersion: "v2",
+			server:     haproxyServerModel{Ciphersuites: types.StringValue("TLS_AES_128_GCM_SHA256"), ForceTlsv12: enabled},
+			wantWarn:   true,
+		},
+		"v2 ciphers forced to TLSv1.3": {
+			apiVersion: "v2",
+			server:     haproxyServerModel{Ciphers: types.StringValue("ECDHE-RSA-AES128-GCM-SHA256"), ForceTlsv13: enabled},
+			wantWarn:   true,
+		},
+		"v3 ciphersuites without TLSv1.3": {
+			apiVersion: "v3",
+			server:     haproxyServerModel{Ciphersuites: types.StringValue("TLS_AES_128_GCM_SHA256"), Tlsv13: disabled},
+			wantWarn:   true,
+		},
+		"v3 ciphers with TLSv1.2": {
+			apiVersion: "v3",
+			server:     haproxyServerModel{Ciphers: types.StringValue("ECDHE-RSA-AES128-GCM-SHA256"), Tlsv10: disabled, Tlsv11: disabled},
+		},
+		"v3 ciphers with TLSv1.3 only": {
+			apiVersion: "v3",
+			server:     haproxyServerModel{Ciphers: types.StringValue("ECDHE-RSA-AES128-GCM-SHA256"), Tlsv10: disabled, Tlsv11: disabled, Tlsv12: disabled},
+			wantWarn:   true,
+		},
+		"v3 v2 protocol field": {
+			apiVersion: "v3",
+			server:     haproxyServerModel{NoTlsv13: enabled},
+			wantErr:    true,
+		},
+		"v2 v3 protocol field": {
+			apiVersion: "v2",
+			server:     haproxyServerModel{Tlsv13: disabled},
+			wantErr:    true,
+		},
+		"v2 agent check without port": {
+			apiVersion: "v2",
+			server:     haproxyServerModel{AgentCheck: enabled},
+			wantErr:    true,
+		},
+		"v3 PROXY protocol v1 and v2": {
+			apiVersion: "v3",
+			server:     haproxyServerModel{SendProxy: enabled, SendProxyV2: enabled},
+			wantErr:    true,
+		},
+	}
+
+	for name, tc := range tests {
+		var diags diag.Diagnostics
+		server := tc.server
+		if tc.apiVersion == "v2" {
+			validateServerV2ForCreate(context.Background(), &diags, &server, "backend.servers[web1]")
+		} else {
+			validateServerV3ForCreate(context.Background(), &diags, &server, "backend.servers[web1]")
+		}
+		if diags.HasError() != tc.wantErr || (diags.WarningsCount() > 0) != tc.wantWarn {
+			t.Errorf("%s: expected error %t and warning %t, got %v", name, tc.wantErr, tc.wantWarn, diags)
+		}
+	}
+}
+
+// Test that backend switching rules round-trip through their payloads and are replaced with a
+// single PUT on v3 and by deleting the old rules first on v2
+func TestBackendSwitchingRuleUpdate(t *testing.T) {
+	t.Parallel()
+
+	rules := []haproxyBackendSwitchingRuleModel{
+		{Name: types.StringValue("api"), Cond: types.StringValue("if"), CondTest: types.StringValue("is_api")},
+		{Name: types.StringValue("static"), Cond: types.StringValue("unless"), CondTest: types.StringValue("is_dynamic")},
+	}
+	manager := CreateBackendSwitchingRuleManager(nil)
+	for i, payload := range manager.convertToBackendSwitchingRulePayloads(rules) {
+		if payload.Index != int64(i) {
+			t.Errorf("backend switching rule %d: expected index %d, got %d", i, i, payload.Index)
+		}
+		if got := manager.convertFromBackendSwitchingRulePayload(payload); !reflect.DeepEqual(got, rules[i]) {
+			t.Errorf("backend switching rule %d: expected %v, got %v", i, rules[i], got)
+		}
+	}
+
+	client, api := newTestClient(t, "v3", map[string]string{
+		"/services/haproxy/configuration/frontends/web/backend_switching_rules": `[{"index":0,"name":"legacy"}]`,
+	})
+	if err := CreateBackendSwitchingRuleManager(client).Update(context.Background(), "test", "web", rules); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []string{`PUT /services/haproxy/configuration/frontends/web/backend_switching_rules [` +
+		`{"index":0,"name":"api","cond":"if","cond_test":"is_api"},` +
+		`{"index":1,"name":"static","cond":"unless","cond_test":"is_dynamic"}]`}
+	if got := api.Writes(); !reflect.DeepEqual(got, want) {
+		t.Errorf("v3: expected a single PUT of the new list\n got: %q\nwant: %q", got, want)
+	}
+
+	client, api = newTestClient(t, "v2", map[string]string{
+		"/services/haproxy/configuration/backend_switching_rules?frontend=web": `{"data":[{"index":0,"name":"legacy"}]}`,
+	})
+	if err := CreateBackendSwitchingRuleManager(client).Update(context.Background(), "test", "web", rules[:1]); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want = []string{
+		`DELETE /services/haproxy/configuration/backend_switching_rules/0?frontend=web`,
+		`POST /services/haproxy/configuration/backend_switching_rules?frontend=web {"index":0,"name":"api","cond":"if","cond_test":"is_api"}`,
+	}
+	if got := api.Writes(); !reflect.DeepEqual(got, want) {
+		t.Errorf("v2: expected the old rules to be deleted before creating the new ones\n got: %q\nwant: %q", got, want)
+	}
+}
+
+// Test that server switching rules must target a server of the backend
+func TestServerSwitchingRuleValidate(t *testing.T) {
+	t.Parallel()
+
+	servers := map[string]haproxyServerModel{"web1": {}}
+	tests := map[string]struct {
+		rule    haproxyServerSwitchingRuleModel
+		wantErr bool
+	}{
+		"known server": {
+			rule: haproxyServerSwitchingRuleModel{TargetServer: types.StringValue("web1"), Cond: types.StringValue("if"), CondTest: types.StringValue("is_pinned")},
+		},
+		"unknown server": {
+			rule:    haproxyServerSwitchingRuleModel{TargetServer: types.StringValue("web2")},
+			wantErr: true,
+		},
+		"server not known until apply": {
+			rule: haproxyServerSwitchingRuleModel{TargetServer: types.StringUnknown()},
+		},
+		"cond without cond_test": {
+			rule:    haproxyServerSwitchingRuleModel{TargetServer: types.StringValue("web1"), Cond: types.StringValue("if")},
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range tests {
+		var diags diag.Diagnostics
+		tc.rule.validate(&diags, path.Root("server_switching_rules").AtListIndex(0), servers)
+		if diags.HasError() != tc.wantErr {
+			t.Errorf("%s: expected error %t, got %v", name, tc.wantErr, diags)
+		}
+	}
+
+	// The rules are checked at plan time together with the other API-independent checks
+	var diags diag.Diagnostics
+	validateStackConfig(&diags, &haproxyStackResourceModel{Backend: &haproxyBackendModel{
+		Servers:              servers,
+		ServerSwitchingRules: []haproxyServerSwitchingRuleModel{{TargetServer: types.StringValue("web2")}},
+	}})
+	if !diags.HasError() {
+		t.Errorf("expected the stack configuration check to report the unknown target server")
+	}
+}
+
+// Test that server switching rules round-trip through their payloads and are replaced with a
+// single PUT on v3 and by deleting the old rules first on v2
+func TestServerSwitchingRuleUpdate(t *testing.T) {
+	t.Parallel()
+
+	rules := []haproxyServerSwitchingRuleModel{
+		{TargetServer: types.StringValue("web1"), Cond: types.StringValue("if"), CondTest: types.StringValue("is_pinned")},
+		{TargetServer: types.StringValue("web2"), Cond: types.StringNull(), CondTest: types.StringNull()},
+	}
+	manager := CreateServerSwitchingRuleManager(nil)
+	for i, payload := range manager.convertToServerSwitchingRulePayloads(rules) {
+		if payload.Index != int64(i) {
+			t.Errorf("server switching rule %d: expected index %d, got %d", i, i, payload.Index)
+		}
+		if got := manager.convertFromServerSwitchingRulePayload(payload); !reflect.DeepEqual(got, rules[i]) {
+			t.Errorf("server switching rule %d: expected %v, got %v", i, rules[i], got)
+		}
+	}
+
+	client, api := newTestClient(t, "v3", map[string]string{
+		"/services/haproxy/configuration/backends/app/server_switching_rules": `[{"index":0,"target_server":"old"}]`,
+	})
+	if err := CreateServerSwitchingRuleManager(client).Update(context.Background(), "test", "app", rules); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []string{`PUT /services/haproxy/configuration/backends/app/server_switching_rules [` +
+		`{"index":0,"target_server":"web1","cond":"if","cond_test":"is_pinned"},` +
+		`{"index":1,"target_server":"web2"}]`}
+	if got := api.Writes(); !reflect.DeepEqual(got, want) {
+		t.Errorf("v3: expected a single PUT of the new list\n got: %q\nwant: %q", got, want)
+	}
+
+	client, api = newTestClient(t, "v2", map[string]string{
+		"/services/haproxy/configuration/server_switching_rules?backend=app": `{"data":[{"index":0,"target_server":"old"}]}`,
+	})
+	if err := CreateServerSwitchingRuleManager(client).Update(context.Background(), "test", "app", rules[1:]); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want = []string{
+		`DELETE /services/haproxy/configuration/server_switching_rules/0?backend=app`,
+		`POST /services/haproxy/configuration/server_switching_rules?backend=app {"index":0,"target_server":"web2"}`,
+	}
+	if got := api.Writes(); !reflect.DeepEqual(got, want) {
+		t.Errorf("v2: expected the old rules to be deleted before creating the new ones\n got: %q\nwant: %q", got, want)
+	}
+}
+
+// Test that http-after-response rules need the arguments of their action, round-trip through
+// their payloads, and are replaced with a single PUT on v3 and by deleting the old rules on v2
+func TestHttpAfterResponseRules(t *testing.T) {
+	t.Parallel()
+
+	rule := func(ruleType string) haproxyHttpAfterResponseRuleModel {
+		return haproxyHttpAfterResponseRuleModel{
+			Type: types.StringValue(ruleType), Cond: types.StringNull(), CondTest: types.StringNull(),
+			HdrName: types.StringNull(), HdrFormat: types.StringNull(), HdrMatch: types.StringNull(),
+			Status: types.Int64Null(), StatusReason: types.StringNull(),
+			VarName: types.StringNull(), VarScope: types.StringNull(), VarExpr: types.StringNull(),
+			MapFile: types.StringNull(), MapKeyfmt: types.StringNull(), MapValuefmt: types.StringNull(),
+			LogLevel: types.StringNull(), StrictMode: types.StringNull(),
+		}
+	}
+	setHeader := rule("set-header")
+	setHeader.HdrName = types.StringValue("Cache-Control")
+	setHeader.HdrFormat = types.StringValue("no-store")
+	setStatus := rule("set-status")
+	setStatus.Status = types.Int64Value(503)
+	setStatus.Cond = types.StringValue("if")
+	setStatus.CondTest = types.StringValue("is_down")
+	missingFormat := rule("set-header")
+	missingFormat.HdrName = types.StringValue("Cache-Control")
+	condWithoutTest := rule("del-header")
+	condWithoutTest.HdrName = types.StringValue("Server")
+	condWithoutTest.Cond = types.StringValue("if")
+
+	tests := map[string]struct {
+		rule    haproxyHttpAfterResponseRuleModel
+		wantErr bool
+	}{
+		"set-header":             {rule: setHeader},
+		"set-status":             {rule: setStatus},
+		"set-header without fmt": {rule: missingFormat, wantErr: true},
+		"cond without cond_test": {rule: condWithoutTest, wantErr: true},
+	}
+	for name, tc := range tests {
+		var diags diag.Diagnostics
+		tc.rule.validate(&diags, path.Root("backend").AtName("http_after_response_rules").AtListIndex(0))
+		if diags.HasError() != tc.wantErr {
+			t.Errorf("%s: expected error %t, got %v", name, tc.wantErr, diags)
+		}
+	}
+
+	rules := []haproxyHttpAfterResponseRuleModel{setHeader, setStatus}
+	manager := CreateHttpAfterResponseRuleManager(nil)
+	for i, payload := range manager.convertToHttpAfterResponseRulePayloads(rules) {
+		if payload.Index != int64(i) {
+			t.Errorf("http-after-response rule %d: expected index %d, got %d", i, i, payload.Index)
+		}
+		if got := manager.convertFromHttpAfterResponseRulePayload(payload); !reflect.DeepEqual(got, rules[i]) {
+			t.Errorf("http-after-response rule %d: expected %v, got %v", i, rules[i], got)
+		}
+	}
+
+	client, api := newTestClient(t, "v3", map[string]string{
+		"/services/haproxy/configuration/backends/app/http_after_response_rules": `[{"index":0,"type":"del-header","hdr_name":"Server"}]`,
+	})
+	if err := CreateHttpAfterResponseRuleManager(client).Update(context.Background(), "test", "backend", "app", rules); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []string{`PUT /services/haproxy/configuration/backends/app/http_after_response_rules [` +
+		`{"index":0,"type":"set-header","hdr_name":"Cache-Control","hdr_format":"no-store"},` +
+		`{"index":1,"type":"set-status","cond":"if","cond_test":"is_down","status":503}]`}
+	if got := api.Writes(); !reflect.DeepEqual(got, want) {
+		t.Errorf("v3: expected a single PUT of the new list\n got: %q\nwant: %q", got, want)
+	}
+
+	client, api = newTestClient(t, "v2", map[string]string{
+		"/services/haproxy/configuration/http_after_response_rules?parent_name=app&parent_type=backend": `{"data":[{"index":0,"type":"del-header","hdr_name":"Server"}]}`,
+	})
+	if err := CreateHttpAfterResponseRuleManager(client).Update(context.Background(), "test", "backend", "app", rules[:1]); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want = []string{
+		`DELETE /services/haproxy/configuration/http_after_response_rules/0?parent_name=app&parent_type=backend`,
+		`POST /services/haproxy/configuration/http_after_response_rules?parent_name=app&parent_type=backend {"index":0,"type":"set-header","hdr_name":"Cache-Control","hdr_format":"no-store"}`,
+	}
+	if got := api.Writes(); !reflect.DeepEqual(got, want) {
+		t.Errorf("v2: expected the old rules to be deleted before creating the new ones\n got: %q\nwant: %q", got, want)
+	}
+}
+
+// Test that filters carry the arguments their type requires
+func TestValidateFilters(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		filters     []haproxyFilterModel
+		compression *haproxyCompressionModel
+		wantErr     bool
+	}{
+		"cache filter": {
+			filters: []haproxyFilterModel{{Type: types.StringValue("cache"), CacheName: types.StringValue("static")}},
+		},
+		"cache filter without cache_name": {
+			filters: []haproxyFilterModel{{Type: types.StringValue("cache")}},
+			wantErr: true,
+		},
+		"per stream bandwidth limitation": {
+			filters: []haproxyFilterModel{{Type: types.StringValue("bwlim-out"), BandwidthLimitName: types.StringValue("limit"), DefaultLimit: types.Int64Value(1024), DefaultPeriod: types.Int64Value(1000)}},
+		},
+		"bandwidth limitation without period": {
+			filters: []haproxyFilterModel{{Type: types.StringValue("bwlim-out"), BandwidthLimitName: types.StringValue("limit"), DefaultLimit: types.Int64Value(1024)}},
+			wantErr: true,
+		},
+		"bandwidth limitation both per stream and shared": {
+			filters: []haproxyFilterModel{{Type: types.StringValue("bwlim-in"), BandwidthLimitName: types.StringValue("limit"), DefaultLimit: types.Int64Value(1024), DefaultPeriod: types.Int64Value(1000), Limit: types.Int64Value(2048), Key: types.StringValue("src")}},
+			wantErr: true,
+		},
+		"compression block without compression filter": {
+			filters:     []haproxyFilterModel{{Type: types.StringValue("trace")}},
+			compression: &haproxyCompressionModel{Algorithms: []types.String{types.StringValue("gzip")}},
+			wantErr:     true,
+		},
+		"compression block with compression filter": {
+			filters:     []haproxyFilterModel{{Type: types.StringValue("trace")}, {Type: types.StringValue("compression")}},
+			compression: &haproxyCompressionModel{Algorithms: []types.String{types.StringValue("gzip")}},
+		},
+		"compression block with a filter type not known until apply": {
+			filters:     []haproxyFilterModel{{Type: types.StringValue("trace")}, {Type: types.StringUnknown()}},
+			compression: &haproxyCompressionModel{Algorithms: []types.String{types.StringValue("gzip")}},
+		},
+		"compression block alone": {
+			compression: &haproxyCompressionModel{Algorithms: []types.String{types.StringValue("gzip")}},
+		},
+	}
+
+	for name, tc := range tests {
+		var diags diag.Diagnostics
+		validateFilters(&diags, tc.filters, tc.compression, path.Root("frontend"))
+		if diags.HasError() != tc.wantErr {
+			t.Errorf("%s: expected error %t, got %v", name, tc.wantErr, diags)
+		}
+	}
+}
+
+// Test that filters and the compression block round-trip through their payloads, and that
+// filters are replaced with a single PUT on v3
+func TestFilterConversions(t *testing.T) {
+	t.Parallel()
+
+	filters := []haproxyFilterModel{
+		{
+			Type:               types.StringValue("bwlim-out"),
+			AppName:            types.StringNull(),
+			BandwidthLimitName: types.StringValue("limit"),
+			CacheName:          types.StringNull(),
+			DefaultLimit:       types.Int64Null(),
+			DefaultPeriod:      types.Int64Null(),
+			Key:                types.StringValue("src"),
+			Limit:              types.Int64Value(2048),
+			MinSize:            types.Int64Null(),
+			SpoeConfig:         types.StringNull(),
+			SpoeEngine:         types.StringNull(),
+			Table:              types.StringValue("limits"),
+			TraceName:          types.StringNull(),
+			TraceHexdump:       types.BoolNull(),
+			TraceRndForwarding: types.BoolNull(),
+			TraceRndParsing:    types.BoolNull(),
+		},
+	}
+	filterManager := CreateFilterManager(nil)
+	for i, payload := range filterManager.convertToFilterPayloads(filters) {
+		if got := filterManager.convertFromFilterPayload(payload); !reflect.DeepEqual(got, filters[i]) {
+			t.Errorf("filter %d: expected %v, got %v", i, filters[i], got)
+		}
+	}
+
+	compression := &haproxyCompressionModel{
+		Algorithms: []types.String{types.StringValue("gzip"), types.StringValue("deflate")},
+		Types:      []types.String{types.StringValue("text/html")},
+		Offload:    types.BoolValue(true),
+	}
+	if got := convertCompressionFromPayload(processCompressionBlock(compression)); !reflect.DeepEqual(got, compression) {
+		t.Errorf("compression: expected %v, got %v", compression, got)
+	}
+	if got := convertCompressionFromPayload(&CompressionPayload{}); got != nil {
+		t.Errorf("compression: expected an empty payload to be read as no block, got %v", got)
+	}
+
+	client, api := newTestClient(t, "v3", map[string]string{
+		"/services/haproxy/configuration/frontends/web/filters": `[{"index":0,"type":"trace"}]`,
+	})
+	if err := CreateFilterManager(client).Update(context.Background(), "test", "frontend", "web", filters); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	writes := api.Writes()
+	if len(writes) != 1 || !strings.HasPrefix(writes[0], "PUT /services/haproxy/configuration/frontends/web/filters [") ||
+		!strings.Contains(writes[0], `"bandwidth_limit_name":"limit"`) {
+		t.Errorf("expected a single PUT of the new filters, got %q", writes)
+	}
+}
+
 // Test that data sources can be created
 func TestDataSourcesCreation(t *testing.T) {
 	t.Parallel()
@@ -43,6 +1768,8 @@ func TestDataSourcesCreation(t *testing.T) {
 		func() interface{} { return NewHttpRequestRuleSingleDataSource() },
 		func() interface{} { return NewHttpResponseRuleDataSource() },
 		func() interface{} { return NewHttpResponseRuleSingleDataSource() },
+		func() interface{} { return NewHttpAfterResponseRuleDataSource() },
+		func() interface{} { return NewHttpAfterResponseRuleSingleDataSource() },
 		func() interface{} { return NewHttpcheckDataSource() },
 		func() interface{} { return NewHttpcheckSingleDataSource() },
 		func() interface{} { return NewTcpCheckDataSource() },
@@ -51,6 +1778,9 @@ func TestDataSourcesCreation(t *testing.T) {
 		func() interface{} { return NewTcpRequestRuleSingleDataSource() },
 		func() interface{} { return NewTcpResponseRuleDataSource() },
 		func() interface{} { return NewTcpResponseRuleSingleDataSource() },
+		func() interface{} { return NewResolversDataSource() },
+		func() interface{} { return NewStatsDataSource() },
+		func() interface{} { return NewInfoDataSource() },
 	}
 
 	for i, dsFunc := range dataSources {
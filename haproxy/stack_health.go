@@ -0,0 +1,234 @@
+package haproxy
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// defaultWaitForHealthyTimeout is the time, in seconds, a stack apply waits for its servers by default.
+	defaultWaitForHealthyTimeout = 300
+	// waitForHealthyInterval is the delay between two polls of the runtime server status.
+	waitForHealthyInterval = 2 * time.Second
+	// defaultDrainTimeout is the time, in seconds, removed servers are drained for by default.
+	defaultDrainTimeout = 60
+)
+
+// GetWaitForHealthySchema returns the schema for the wait_for_healthy block
+func GetWaitForHealthySchema() schema.SingleNestedBlock {
+	return schema.SingleNestedBlock{
+		Description: "Wait after the configuration is committed until the servers of the backend are UP. The apply fails if fewer than min_healthy servers are UP when timeout expires.",
+		Attributes: map[string]schema.Attribute{
+			"min_healthy": schema.Int64Attribute{
+				Optional:    true,
+				Description: "The number of servers of backend.servers that must be UP. Defaults to all of them.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"timeout": schema.Int64Attribute{
+				Optional:    true,
+				Description: fmt.Sprintf("The maximum time to wait, in seconds. Defaults to %d.", defaultWaitForHealthyTimeout),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+// validateStackWaitForHealthy checks that wait_for_healthy has servers to wait for.
+func validateStackWaitForHealthy(diags *diag.Diagnostics, config *haproxyStackResourceModel) {
+	if config.WaitForHealthy == nil {
+		return
+	}
+	if config.Backend == nil || len(config.Backend.Servers) == 0 {
+		diags.AddAttributeError(path.Root("wait_for_healthy"), "Invalid wait_for_healthy block",
+			"wait_for_healthy requires a backend block with at least one server.")
+		return
+	}
+	minHealthy := config.WaitForHealthy.MinHealthy
+	if !minHealthy.IsNull() && !minHealthy.IsUnknown() && minHealthy.ValueInt64() > int64(len(config.Backend.Servers)) {
+		diags.AddAttributeError(path.Root("wait_for_healthy").AtName("min_healthy"), "Invalid min_healthy",
+			fmt.Sprintf("min_healthy is %d but the backend only has %d servers.", minHealthy.ValueInt64(), len(config.Backend.Servers)))
+	}
+}
+
+// WaitForHealthy polls the runtime status of the servers of the stack backend until at least
+// min_healthy of them are UP. It runs after the commit and outside the transaction lock; when
+// the threshold is not met in time, an error is added for every server that is not UP.
+func (o *StackOperations) WaitForHealthy(ctx context.Context, data *haproxyStackResourceModel, diags *diag.Diagnostics) {
+	if data.WaitForHealthy == nil || data.Backend == nil || len(data.Backend.Servers) == 0 {
+		return
+	}
+
+	backendName := data.Backend.Name.ValueString()
+	names := make([]string, 0, len(data.Backend.Servers))
+	for name := range data.Backend.Servers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	minHealthy := int64(len(names))
+	if !data.WaitForHealthy.MinHealthy.IsNull() && !data.WaitForHealthy.MinHealthy.IsUnknown() {
+		minHealthy = data.WaitForHealthy.MinHealthy.ValueInt64()
+	}
+	timeout := int64(defaultWaitForHealthyTimeout)
+	if !data.WaitForHealthy.Timeout.IsNull() && !data.WaitForHealthy.Timeout.IsUnknown() {
+		timeout = data.WaitForHealthy.Timeout.ValueInt64()
+	}
+	deadline := time.Now().Add(time.Duration(timeout) * time.Second)
+
+	var status map[string]RuntimeServerPayload
+	var healthy int64
+	for {
+		servers, err := o.client.ReadRuntimeServers(ctx, backendName)
+		if err != nil {
+			tflog.Warn(ctx, "Could not read runtime server status", map[string]interface{}{"backend_name": backendName, "error": err.Error()})
+		} else {
+			status = make(map[string]RuntimeServerPayload, len(servers))
+			for _, server := range servers {
+				status[server.Name] = server
+			}
+			healthy = 0
+			for _, name := range names {
+				if status[name].OperationalState == "up" {
+					healthy++
+				}
+			}
+			tflog.Info(ctx, "Waiting for healthy servers", map[string]interface{}{
+				"backend_name": backendName,
+				"healthy":      healthy,
+				"min_healthy":  minHealthy,
+			})
+			if healthy >= minHealthy {
+				return
+			}
+		}
+
+		if time.Now().After(deadline) {
+			break
+		}
+		select {
+		case <-ctx.Done():
+			diags.AddError("Error waiting for healthy servers", fmt.Sprintf("Stopped waiting for the servers of backend %s: %s", backendName, ctx.Err()))
+			return
+		case <-time.After(waitForHealthyInterval):
+		}
+	}
+
+	if status == nil {
+		diags.AddError("Error waiting for healthy servers", fmt.Sprintf("Could not read the runtime status of the servers of backend %s within %d seconds.", backendName, timeout))
+		return
+	}
+	for _, name := range names {
+		server, ok := status[name]
+		if ok && server.OperationalState == "up" {
+			continue
+		}
+		detail := fmt.Sprintf("Server %s of backend %s is not in the running process", name, backendName)
+		if ok {
+			detail = fmt.Sprintf("Server %s of backend %s is %s (admin state %s)", name, backendName, server.OperationalState, server.AdminState)
+		}
+		diags.AddAttributeError(
+			path.Root("backend").AtName("servers").AtMapKey(name),
+			"Server not healthy",
+			fmt.Sprintf("%s after %d seconds; %d of the %d required servers are UP.", detail, timeout, healthy, minHealthy),
+		)
+	}
+}
+
+// drainRemovedServers puts the servers removed from backend.servers into drain through the
+// runtime API and waits until their current sessions reach zero or the drain timeout expires,
+// so that the transaction deleting them does not cut live sessions. It only applies when the
+// backend has drain_before_delete and runs before the transaction lock is taken.
+func (o *StackOperations) drainRemovedServers(ctx context.Context, req resource.UpdateRequest, data *haproxyStackResourceModel) error {
+	if data.Backend == nil || data.Backend.DrainBeforeDelete == nil || len(data.Backend.Servers) == 0 {
+		return nil
+	}
+
+	var state haproxyStackResourceModel
+	if diags := req.State.Get(ctx, &state); diags.HasError() {
+		return fmt.Errorf("failed to get state data")
+	}
+	if state.Backend == nil || state.Backend.Name.ValueString() != data.Backend.Name.ValueString() {
+		return nil
+	}
+
+	backendName := data.Backend.Name.ValueString()
+	var draining []string
+	for name := range state.Backend.Servers {
+		if _, kept := data.Backend.Servers[name]; kept {
+			continue
+		}
+		server, err := o.client.ReadRuntimeServer(ctx, backendName, name)
+		if err != nil {
+			return fmt.Errorf("error reading runtime state of server %s: %w", name, err)
+		}
+		if server == nil {
+			continue
+		}
+		if server.AdminState != "drain" && server.AdminState != "maint" {
+			tflog.Info(ctx, "Draining server before deletion", map[string]interface{}{"backend_name": backendName, "server_name": name})
+			if err := o.client.UpdateRuntimeServer(ctx, backendName, name, &RuntimeServerPayload{AdminState: "drain"}); err != nil {
+				return fmt.Errorf("error draining server %s: %w", name, err)
+			}
+		}
+		draining = append(draining, name)
+	}
+	if len(draining) == 0 {
+		return nil
+	}
+	sort.Strings(draining)
+
+	timeout := int64(defaultDrainTimeout)
+	if !data.Backend.DrainBeforeDelete.Timeout.IsNull() && !data.Backend.DrainBeforeDelete.Timeout.IsUnknown() {
+		timeout = data.Backend.DrainBeforeDelete.Timeout.ValueInt64()
+	}
+	deadline := time.Now().Add(time.Duration(timeout) * time.Second)
+
+	for {
+		var active []string
+		for _, name := range draining {
+			stats, err := o.client.ReadNativeStats(ctx, "server", name, backendName)
+			if err != nil {
+				tflog.Warn(ctx, "Could not read server sessions", map[string]interface{}{"server_name": name, "error": err.Error()})
+				active = append(active, name)
+				continue
+			}
+			for _, stat := range stats {
+				if stat.Stats.Scur != nil && *stat.Stats.Scur > 0 {
+					active = append(active, name)
+					break
+				}
+			}
+		}
+		if len(active) == 0 {
+			return nil
+		}
+
+		if time.Now().After(deadline) {
+			tflog.Warn(ctx, "Drain timeout expired, deleting servers with active sessions", map[string]interface{}{
+				"backend_name": backendName,
+				"servers":      strings.Join(active, ","),
+			})
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(waitForHealthyInterval):
+		}
+	}
+}
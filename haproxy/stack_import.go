@@ -0,0 +1,358 @@
+package haproxy
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Import builds a complete haproxy_stack model from an existing frontend and/or backend in HAProxy
+func (o *StackOperations) Import(ctx context.Context, frontendName, backendName string) (*haproxyStackResourceModel, error) {
+	tflog.Info(ctx, "Importing HAProxy stack", map[string]interface{}{
+		"frontend_name": frontendName,
+		"backend_name":  backendName,
+	})
+
+	data := &haproxyStackResourceModel{}
+
+	// Use the frontend name as stack name, fall back to the backend name
+	if frontendName != "" {
+		data.Name = types.StringValue(frontendName)
+	} else {
+		data.Name = types.StringValue(backendName)
+	}
+
+	if backendName != "" {
+		backend, err := o.importBackend(ctx, backendName)
+		if err != nil {
+			return nil, err
+		}
+		data.Backend = backend
+	}
+
+	if frontendName != "" {
+		frontend, err := o.importFrontend(ctx, frontendName)
+		if err != nil {
+			return nil, err
+		}
+		data.Frontend = frontend
+	}
+
+	tflog.Info(ctx, "HAProxy stack imported successfully")
+	return data, nil
+}
+
+// importBackend reads a backend with its servers, rules and checks from HAProxy
+func (o *StackOperations) importBackend(ctx context.Context, backendName string) (*haproxyBackendModel, error) {
+	// Backend, default_server, stick_table and ACLs
+	backend, err := o.backendManager.ReadBackend(ctx, backendName, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error reading backend: %w", err)
+	}
+
+	// Servers
+	servers, err := o.client.ReadServers(ctx, "backend", backendName)
+	if err != nil {
+		return nil, fmt.Errorf("error reading servers for backend %s: %w", backendName, err)
+	}
+	if len(servers) > 0 {
+		backend.Servers = make(map[string]haproxyServerModel, len(servers))
+		for _, server := range servers {
+			backend.Servers[server.Name] = o.convertServerPayloadToModel(server)
+		}
+	}
+
+	// HTTP request rules
+	httpRequestRules, err := o.httpRequestRuleManager.ReadHttpRequestRules(ctx, "backend", backendName)
+	if err != nil {
+		return nil, fmt.Errorf("error reading HTTP request rules for backend %s: %w", backendName, err)
+	}
+	for i := range httpRequestRules {
+		backend.HttpRequestRules = append(backend.HttpRequestRules, o.httpRequestRuleManager.convertFromHttpRequestRulePayload(&httpRequestRules[i]))
+	}
+
+	// HTTP response rules
+	httpResponseRules, err := o.httpResponseRuleManager.ReadHttpResponseRules(ctx, "backend", backendName)
+	if err != nil {
+		return nil, fmt.Errorf("error reading HTTP response rules for backend %s: %w", backendName, err)
+	}
+	for i := range httpResponseRules {
+		backend.HttpResponseRules = append(backend.HttpResponseRules, o.httpResponseRuleManager.convertFromHttpResponseRulePayload(&httpResponseRules[i]))
+	}
+
+	// HTTP after-response rules
+	httpAfterResponseRules, err := o.httpAfterResponseRuleManager.Read(ctx, "backend", backendName)
+	if err != nil {
+		return nil, fmt.Errorf("error reading HTTP after-response rules for backend %s: %w", backendName, err)
+	}
+	if len(httpAfterResponseRules) > 0 {
+		backend.HttpAfterResponseRules = httpAfterResponseRules
+	}
+
+	// Filters
+	filters, err := o.filterManager.Read(ctx, "backend", backendName)
+	if err != nil {
+		return nil, fmt.Errorf("error reading filters for backend %s: %w", backendName, err)
+	}
+	if len(filters) > 0 {
+		backend.Filters = filters
+	}
+
+	// TCP request rules
+	tcpRequestRules, err := o.tcpRequestRuleManager.Read(ctx, "backend", backendName)
+	if err != nil {
+		return nil, fmt.Errorf("error reading TCP request rules for backend %s: %w", backendName, err)
+	}
+	backend.TcpRequestRules = o.convertTcpRequestRulesToStackModels(tcpRequestRules)
+
+	// TCP response rules
+	tcpResponseRules, err := o.tcpResponseRuleManager.Read(ctx, "backend", backendName)
+	if err != nil {
+		return nil, fmt.Errorf("error reading TCP response rules for backend %s: %w", backendName, err)
+	}
+	backend.TcpResponseRules = o.convertTcpResponseRulesToStackModels(tcpResponseRules)
+
+	// Stick rules
+	stickRules, err := o.stickRuleManager.Read(ctx, backendName)
+	if err != nil {
+		return nil, fmt.Errorf("error reading stick rules for backend %s: %w", backendName, err)
+	}
+	if len(stickRules) > 0 {
+		backend.StickRules = stickRules
+	}
+
+	// Server switching rules
+	serverSwitchingRules, err := o.serverSwitchingRuleManager.Read(ctx, backendName)
+	if err != nil {
+		return nil, fmt.Errorf("error reading server switching rules for backend %s: %w", backendName, err)
+	}
+	if len(serverSwitchingRules) > 0 {
+		backend.ServerSwitchingRules = serverSwitchingRules
+	}
+
+	// HTTP checks
+	httpchecks, err := o.httpcheckManager.Read(ctx, "backend", backendName)
+	if err != nil {
+		return nil, fmt.Errorf("error reading HTTP checks for backend %s: %w", backendName, err)
+	}
+	backend.Httpchecks = o.convertHttpchecksToStackModels(httpchecks)
+
+	// TCP checks
+	tcpChecks, err := o.client.ReadTcpChecks(ctx, "backend", backendName)
+	if err != nil {
+		return nil, fmt.Errorf("error reading TCP checks for backend %s: %w", backendName, err)
+	}
+	for _, tcpCheck := range tcpChecks {
+		backend.TcpChecks = append(backend.TcpChecks, o.convertTcpCheckPayloadToStackModel(tcpCheck))
+	}
+
+	return backend, nil
+}
+
+// importFrontend reads a frontend with its binds and rules from HAProxy
+func (o *StackOperations) importFrontend(ctx context.Context, frontendName string) (*haproxyFrontendModel, error) {
+	// ReadFrontend does not fail on 404, so check that the frontend exists first
+	existing, err := o.client.ReadFrontend(ctx, frontendName)
+	if err != nil {
+		return nil, fmt.Errorf("error reading frontend: %w", err)
+	}
+	if existing == nil {
+		return nil, fmt.Errorf("frontend %s not found", frontendName)
+	}
+
+	// Frontend, ACLs and HTTP request rules
+	frontend, err := o.frontendManager.ReadFrontend(ctx, frontendName, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error reading frontend: %w", err)
+	}
+
+	// Binds
+	binds, err := o.bindManager.ReadBinds(ctx, "frontend", frontendName)
+	if err != nil {
+		return nil, fmt.Errorf("error reading binds for frontend %s: %w", frontendName, err)
+	}
+	if len(binds) > 0 {
+		frontend.Binds = make(map[string]haproxyBindModel, len(binds))
+		for _, bind := range binds {
+			frontend.Binds[bind.Name] = o.bindManager.convertFromBindPayload(bind)
+		}
+	}
+
+	// HTTP response rules
+	httpResponseRules, err := o.httpResponseRuleManager.ReadHttpResponseRules(ctx, "frontend", frontendName)
+	if err != nil {
+		return nil, fmt.Errorf("error reading HTTP response rules for frontend %s: %w", frontendName, err)
+	}
+	for i := range httpResponseRules {
+		frontend.HttpResponseRules = append(frontend.HttpResponseRules, o.httpResponseRuleManager.convertFromHttpResponseRulePayload(&httpResponseRules[i]))
+	}
+
+	// HTTP after-response rules
+	httpAfterResponseRules, err := o.httpAfterResponseRuleManager.Read(ctx, "frontend", frontendName)
+	if err != nil {
+		return nil, fmt.Errorf("error reading HTTP after-response rules for frontend %s: %w", frontendName, err)
+	}
+	if len(httpAfterResponseRules) > 0 {
+		frontend.HttpAfterResponseRules = httpAfterResponseRules
+	}
+
+	// Filters
+	filters, err := o.filterManager.Read(ctx, "frontend", frontendName)
+	if err != nil {
+		return nil, fmt.Errorf("error reading filters for frontend %s: %w", frontendName, err)
+	}
+	if len(filters) > 0 {
+		frontend.Filters = filters
+	}
+
+	// TCP request rules
+	tcpRequestRules, err := o.tcpRequestRuleManager.Read(ctx, "frontend", frontendName)
+	if err != nil {
+		return nil, fmt.Errorf("error reading TCP request rules for frontend %s: %w", frontendName, err)
+	}
+	frontend.TcpRequestRules = o.convertTcpRequestRulesToStackModels(tcpRequestRules)
+
+	// Backend switching rules
+	backendSwitchingRules, err := o.backendSwitchingRuleManager.Read(ctx, frontendName)
+	if err != nil {
+		return nil, fmt.Errorf("error reading backend switching rules for frontend %s: %w", frontendName, err)
+	}
+	if len(backendSwitchingRules) > 0 {
+		frontend.BackendSwitchingRules = backendSwitchingRules
+	}
+
+	return frontend, nil
+}
+
+// convertTcpRequestRulesToStackModels converts resource models to stack models
+func (o *StackOperations) convertTcpRequestRulesToStackModels(resourceRules []TcpRequestRuleResourceModel) []haproxyTcpRequestRuleModel {
+	if len(resourceRules) == 0 {
+		return nil
+	}
+	stackRules := make([]haproxyTcpRequestRuleModel, len(resourceRules))
+	for i, resourceRule := range resourceRules {
+		stackRules[i] = haproxyTcpRequestRuleModel{
+			Type:                 resourceRule.Type,
+			Action:               resourceRule.Action,
+			Cond:                 resourceRule.Cond,
+			CondTest:             resourceRule.CondTest,
+			Expr:                 resourceRule.Expr,
+			Timeout:              resourceRule.Timeout,
+			LuaAction:            resourceRule.LuaAction,
+			LuaParams:            resourceRule.LuaParams,
+			LogLevel:             resourceRule.LogLevel,
+			MarkValue:            resourceRule.MarkValue,
+			NiceValue:            resourceRule.NiceValue,
+			TosValue:             resourceRule.TosValue,
+			CaptureLen:           resourceRule.CaptureLen,
+			CaptureSample:        resourceRule.CaptureSample,
+			BandwidthLimitLimit:  resourceRule.BandwidthLimitLimit,
+			BandwidthLimitName:   resourceRule.BandwidthLimitName,
+			BandwidthLimitPeriod: resourceRule.BandwidthLimitPeriod,
+			ResolveProtocol:      resourceRule.ResolveProtocol,
+			ResolveResolvers:     resourceRule.ResolveResolvers,
+			ResolveVar:           resourceRule.ResolveVar,
+			RstTtl:               resourceRule.RstTtl,
+			ScIdx:                resourceRule.ScIdx,
+			ScIncId:              resourceRule.ScIncId,
+			ScInt:                resourceRule.ScInt,
+			ServerName:           resourceRule.ServerName,
+			ServiceName:          resourceRule.ServiceName,
+			TrackScKey:           resourceRule.TrackKey,
+			TrackScStickCounter:  resourceRule.TrackStickCounter,
+			TrackScTable:         resourceRule.TrackTable,
+			VarName:              resourceRule.VarName,
+			VarFormat:            resourceRule.VarFormat,
+			VarScope:             resourceRule.VarScope,
+			VarExpr:              resourceRule.VarExpr,
+		}
+	}
+	return stackRules
+}
+
+// convertTcpResponseRulesToStackModels converts resource models to stack models
+func (o *StackOperations) convertTcpResponseRulesToStackModels(resourceRules []TcpResponseRuleResourceModel) []haproxyTcpResponseRuleModel {
+	if len(resourceRules) == 0 {
+		return nil
+	}
+	stackRules := make([]haproxyTcpResponseRuleModel, len(resourceRules))
+	for i, resourceRule := range resourceRules {
+		stackRules[i] = haproxyTcpResponseRuleModel{
+			Type:                 resourceRule.Type,
+			Action:               resourceRule.Action,
+			Cond:                 resourceRule.Cond,
+			CondTest:             resourceRule.CondTest,
+			Expr:                 resourceRule.Expr,
+			LogLevel:             resourceRule.LogLevel,
+			LuaAction:            resourceRule.LuaAction,
+			LuaParams:            resourceRule.LuaParams,
+			MarkValue:            resourceRule.MarkValue,
+			NiceValue:            resourceRule.NiceValue,
+			RstTtl:               resourceRule.RstTtl,
+			ScExpr:               resourceRule.ScExpr,
+			ScId:                 resourceRule.ScId,
+			ScIdx:                resourceRule.ScIdx,
+			ScInt:                resourceRule.ScInt,
+			SpoeEngine:           resourceRule.SpoeEngine,
+			SpoeGroup:            resourceRule.SpoeGroup,
+			Timeout:              resourceRule.Timeout,
+			TosValue:             resourceRule.TosValue,
+			VarFormat:            resourceRule.VarFormat,
+			VarName:              resourceRule.VarName,
+			VarScope:             resourceRule.VarScope,
+			VarExpr:              resourceRule.VarExpr,
+			BandwidthLimitLimit:  resourceRule.BandwidthLimitLimit,
+			BandwidthLimitName:   resourceRule.BandwidthLimitName,
+			BandwidthLimitPeriod: resourceRule.BandwidthLimitPeriod,
+		}
+	}
+	return stackRules
+}
+
+// convertHttpchecksToStackModels converts resource models to stack models
+func (o *StackOperations) convertHttpchecksToStackModels(resourceChecks []HttpcheckResourceModel) []haproxyHttpcheckModel {
+	if len(resourceChecks) == 0 {
+		return nil
+	}
+	stackChecks := make([]haproxyHttpcheckModel, len(resourceChecks))
+	for i, resourceCheck := range resourceChecks {
+		stackChecks[i] = haproxyHttpcheckModel{
+			Type:            resourceCheck.Type,
+			Addr:            resourceCheck.Addr,
+			Alpn:            resourceCheck.Alpn,
+			Body:            resourceCheck.Body,
+			BodyLogFormat:   resourceCheck.BodyLogFormat,
+			CheckComment:    resourceCheck.CheckComment,
+			Default:         resourceCheck.Default,
+			ErrorStatus:     resourceCheck.ErrorStatus,
+			ExclamationMark: resourceCheck.ExclamationMark,
+			Headers:         resourceCheck.Headers,
+			Linger:          resourceCheck.Linger,
+			Match:           resourceCheck.Match,
+			Method:          resourceCheck.Method,
+			MinRecv:         resourceCheck.MinRecv,
+			OkStatus:        resourceCheck.OkStatus,
+			OnError:         resourceCheck.OnError,
+			OnSuccess:       resourceCheck.OnSuccess,
+			Pattern:         resourceCheck.Pattern,
+			Port:            resourceCheck.Port,
+			PortString:      resourceCheck.PortString,
+			Proto:           resourceCheck.Proto,
+			SendProxy:       resourceCheck.SendProxy,
+			Sni:             resourceCheck.Sni,
+			Ssl:             resourceCheck.Ssl,
+			StatusCode:      resourceCheck.StatusCode,
+			ToutStatus:      resourceCheck.ToutStatus,
+			Uri:             resourceCheck.Uri,
+			UriLogFormat:    resourceCheck.UriLogFormat,
+			VarExpr:         resourceCheck.VarExpr,
+			VarFormat:       resourceCheck.VarFormat,
+			VarName:         resourceCheck.VarName,
+			VarScope:        resourceCheck.VarScope,
+			Version:         resourceCheck.Version,
+		}
+	}
+	return stackChecks
+}
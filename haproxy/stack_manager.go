@@ -2,6 +2,8 @@ package haproxy
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -19,13 +21,18 @@ type StackManager struct {
 func CreateStackManager(client *HAProxyClient, aclManager *ACLManager, frontendManager *FrontendManager, backendManager *BackendManager) *StackManager {
 	httpRequestRuleManager := CreateHttpRequestRuleManager(client)
 	httpResponseRuleManager := CreateHttpResponseRuleManager(client)
+	httpAfterResponseRuleManager := CreateHttpAfterResponseRuleManager(client)
 	tcpRequestRuleManager := CreateTcpRequestRuleManager(client)
 	tcpResponseRuleManager := CreateTcpResponseRuleManager(client)
+	stickRuleManager := CreateStickRuleManager(client)
+	backendSwitchingRuleManager := CreateBackendSwitchingRuleManager(client)
+	serverSwitchingRuleManager := CreateServerSwitchingRuleManager(client)
+	filterManager := CreateFilterManager(client)
 	httpcheckManager := CreateHttpcheckManager(client)
 	tcpCheckManager := CreateTcpCheckManager(client)
 	bindManager := CreateBindManager(client)
 	return &StackManager{
-		operations: CreateStackOperations(client, aclManager, frontendManager, backendManager, httpRequestRuleManager, httpResponseRuleManager, tcpRequestRuleManager, tcpResponseRuleManager, httpcheckManager, tcpCheckManager, bindManager),
+		operations: CreateStackOperations(client, aclManager, frontendManager, backendManager, httpRequestRuleManager, httpResponseRuleManager, httpAfterResponseRuleManager, tcpRequestRuleManager, tcpResponseRuleManager, stickRuleManager, backendSwitchingRuleManager, serverSwitchingRuleManager, filterManager, httpcheckManager, tcpCheckManager, bindManager),
 		validation: CreateStackValidation(),
 		processors: CreateStackProcessors(),
 	}
@@ -63,8 +70,10 @@ func (m *StackManager) Create(ctx context.Context, req resource.CreateRequest, r
 		return err
 	}
 
-	// Set the state
+	// Set the state before waiting, so that the committed configuration is tracked even if
+	// the servers do not become healthy
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	m.operations.WaitForHealthy(ctx, data, &resp.Diagnostics)
 	return nil
 }
 
@@ -101,8 +110,10 @@ func (m *StackManager) Update(ctx context.Context, req resource.UpdateRequest, r
 		return err
 	}
 
-	// Set the state
+	// Set the state before waiting, so that the committed configuration is tracked even if
+	// the servers do not become healthy
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	m.operations.WaitForHealthy(ctx, data, &resp.Diagnostics)
 	return nil
 }
 
@@ -123,6 +134,34 @@ func (m *StackManager) Delete(ctx context.Context, req resource.DeleteRequest, r
 	return nil
 }
 
+// ImportState handles the import operation for the haproxy_stack resource
+// The import ID has the format <frontend>/<backend>; either side may be empty
+func (m *StackManager) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) error {
+	frontendName, backendName, err := parseStackImportID(req.ID)
+	if err != nil {
+		return err
+	}
+
+	// Execute the import operation
+	data, err := m.operations.Import(ctx, frontendName, backendName)
+	if err != nil {
+		return err
+	}
+
+	// Set the state
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	return nil
+}
+
+// parseStackImportID splits an import ID of the form <frontend>/<backend>; either side may be empty
+func parseStackImportID(id string) (frontendName, backendName string, err error) {
+	parts := strings.Split(id, "/")
+	if len(parts) != 2 || (parts[0] == "" && parts[1] == "") {
+		return "", "", fmt.Errorf("import ID must be in the format: <frontend>/<backend> (got %q)", id)
+	}
+	return parts[0], parts[1], nil
+}
+
 // Configure handles the configuration of the stack manager
 func (m *StackManager) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// This method can be used for any additional configuration
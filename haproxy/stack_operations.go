@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"slices"
 	"strconv"
 	"strings"
 	"sync"
@@ -20,33 +21,43 @@ var globalTransactionMutex sync.Mutex
 
 // StackOperations handles all CRUD operations for the haproxy_stack resource
 type StackOperations struct {
-	client                  *HAProxyClient
-	aclManager              *ACLManager
-	frontendManager         *FrontendManager
-	backendManager          *BackendManager
-	httpRequestRuleManager  *HttpRequestRuleManager
-	httpResponseRuleManager *HttpResponseRuleManager
-	tcpRequestRuleManager   *TcpRequestRuleManager
-	tcpResponseRuleManager  *TcpResponseRuleManager
-	httpcheckManager        *HttpcheckManager
-	tcpCheckManager         *TcpCheckManager
-	bindManager             *BindManager
+	client                       *HAProxyClient
+	aclManager                   *ACLManager
+	frontendManager              *FrontendManager
+	backendManager               *BackendManager
+	httpRequestRuleManager       *HttpRequestRuleManager
+	httpResponseRuleManager      *HttpResponseRuleManager
+	httpAfterResponseRuleManager *HttpAfterResponseRuleManager
+	tcpRequestRuleManager        *TcpRequestRuleManager
+	tcpResponseRuleManager       *TcpResponseRuleManager
+	stickRuleManager             *StickRuleManager
+	backendSwitchingRuleManager  *BackendSwitchingRuleManager
+	serverSwitchingRuleManager   *ServerSwitchingRuleManager
+	filterManager                *FilterManager
+	httpcheckManager             *HttpcheckManager
+	tcpCheckManager              *TcpCheckManager
+	bindManager                  *BindManager
 }
 
 // CreateStackOperations creates a new StackOperations instance
-func CreateStackOperations(client *HAProxyClient, aclManager *ACLManager, frontendManager *FrontendManager, backendManager *BackendManager, httpRequestRuleManager *HttpRequestRuleManager, httpResponseRuleManager *HttpResponseRuleManager, tcpRequestRuleManager *TcpRequestRuleManager, tcpResponseRuleManager *TcpResponseRuleManager, httpcheckManager *HttpcheckManager, tcpCheckManager *TcpCheckManager, bindManager *BindManager) *StackOperations {
+func CreateStackOperations(client *HAProxyClient, aclManager *ACLManager, frontendManager *FrontendManager, backendManager *BackendManager, httpRequestRuleManager *HttpRequestRuleManager, httpResponseRuleManager *HttpResponseRuleManager, httpAfterResponseRuleManager *HttpAfterResponseRuleManager, tcpRequestRuleManager *TcpRequestRuleManager, tcpResponseRuleManager *TcpResponseRuleManager, stickRuleManager *StickRuleManager, backendSwitchingRuleManager *BackendSwitchingRuleManager, serverSwitchingRuleManager *ServerSwitchingRuleManager, filterManager *FilterManager, httpcheckManager *HttpcheckManager, tcpCheckManager *TcpCheckManager, bindManager *BindManager) *StackOperations {
 	stackOps := &StackOperations{
-		client:                  client,
-		aclManager:              aclManager,
-		backendManager:          backendManager,
-		frontendManager:         frontendManager,
-		httpRequestRuleManager:  httpRequestRuleManager,
-		httpResponseRuleManager: httpResponseRuleManager,
-		tcpRequestRuleManager:   tcpRequestRuleManager,
-		tcpResponseRuleManager:  tcpResponseRuleManager,
-		httpcheckManager:        httpcheckManager,
-		tcpCheckManager:         tcpCheckManager,
-		bindManager:             bindManager,
+		client:                       client,
+		aclManager:                   aclManager,
+		backendManager:               backendManager,
+		frontendManager:              frontendManager,
+		httpRequestRuleManager:       httpRequestRuleManager,
+		httpResponseRuleManager:      httpResponseRuleManager,
+		httpAfterResponseRuleManager: httpAfterResponseRuleManager,
+		tcpRequestRuleManager:        tcpRequestRuleManager,
+		tcpResponseRuleManager:       tcpResponseRuleManager,
+		stickRuleManager:             stickRuleManager,
+		backendSwitchingRuleManager:  backendSwitchingRuleManager,
+		serverSwitchingRuleManager:   serverSwitchingRuleManager,
+		filterManager:                filterManager,
+		httpcheckManager:             httpcheckManager,
+		tcpCheckManager:              tcpCheckManager,
+		bindManager:                  bindManager,
 	}
 
 	return stackOps
@@ -69,7 +80,48 @@ func (o *StackOperations) serverNeedsUpdate(existing ServerPayload, desired Serv
 		existing.Inter != desired.Inter ||
 		existing.Rise != desired.Rise ||
 		existing.Ssl != desired.Ssl ||
-		existing.Verify != desired.Verify
+		existing.Verify != desired.Verify ||
+		existing.Maxqueue != desired.Maxqueue ||
+		existing.Minconn != desired.Minconn ||
+		existing.Slowstart != desired.Slowstart ||
+		existing.InitAddr != desired.InitAddr ||
+		existing.Maintenance != desired.Maintenance ||
+		existing.Track != desired.Track ||
+		existing.Observe != desired.Observe ||
+		existing.ErrorLimit != desired.ErrorLimit ||
+		existing.OnError != desired.OnError ||
+		existing.OnMarkedDown != desired.OnMarkedDown ||
+		existing.OnMarkedUp != desired.OnMarkedUp ||
+		existing.HealthCheckPort != desired.HealthCheckPort ||
+		existing.AgentCheck != desired.AgentCheck ||
+		existing.AgentAddr != desired.AgentAddr ||
+		existing.AgentPort != desired.AgentPort ||
+		existing.AgentInter != desired.AgentInter ||
+		existing.AgentSend != desired.AgentSend ||
+		existing.Proto != desired.Proto ||
+		existing.Alpn != desired.Alpn ||
+		existing.Sni != desired.Sni ||
+		existing.VerifyHost != desired.VerifyHost ||
+		existing.Ciphers != desired.Ciphers ||
+		existing.Ciphersuites != desired.Ciphersuites ||
+		existing.SslReuse != desired.SslReuse ||
+		existing.TlsTickets != desired.TlsTickets ||
+		existing.CheckSsl != desired.CheckSsl ||
+		existing.CheckSni != desired.CheckSni ||
+		existing.CheckAlpn != desired.CheckAlpn ||
+		existing.SendProxy != desired.SendProxy ||
+		existing.SendProxyV2 != desired.SendProxyV2 ||
+		existing.SendProxyV2Ssl != desired.SendProxyV2Ssl ||
+		existing.SendProxyV2SslCn != desired.SendProxyV2SslCn ||
+		existing.PoolLowConn != desired.PoolLowConn ||
+		existing.PoolMaxConn != desired.PoolMaxConn ||
+		existing.PoolPurgeDelay != desired.PoolPurgeDelay ||
+		existing.Source != desired.Source ||
+		existing.Redir != desired.Redir ||
+		existing.Stick != desired.Stick ||
+		existing.Tfo != desired.Tfo ||
+		existing.LogProto != desired.LogProto ||
+		!slices.Equal(existing.ProxyV2Options, desired.ProxyV2Options)
 }
 
 // convertServerPayloadToModel converts a ServerPayload to haproxyServerModel
@@ -123,6 +175,129 @@ func (o *StackOperations) convertServerPayloadToModel(server ServerPayload) hapr
 	if server.Cookie != "" {
 		model.Cookie = types.StringValue(server.Cookie)
 	}
+	if server.Maxqueue != 0 {
+		model.Maxqueue = types.Int64Value(server.Maxqueue)
+	}
+	if server.Minconn != 0 {
+		model.Minconn = types.Int64Value(server.Minconn)
+	}
+	if server.Slowstart != 0 {
+		model.Slowstart = types.Int64Value(server.Slowstart)
+	}
+	if server.InitAddr != "" {
+		model.InitAddr = types.StringValue(server.InitAddr)
+	}
+	if server.Maintenance != "" {
+		model.Maintenance = types.StringValue(server.Maintenance)
+	}
+	if server.Track != "" {
+		model.Track = types.StringValue(server.Track)
+	}
+	if server.Observe != "" {
+		model.Observe = types.StringValue(server.Observe)
+	}
+	if server.ErrorLimit != 0 {
+		model.ErrorLimit = types.Int64Value(server.ErrorLimit)
+	}
+	if server.OnError != "" {
+		model.OnError = types.StringValue(server.OnError)
+	}
+	if server.OnMarkedDown != "" {
+		model.OnMarkedDown = types.StringValue(server.OnMarkedDown)
+	}
+	if server.OnMarkedUp != "" {
+		model.OnMarkedUp = types.StringValue(server.OnMarkedUp)
+	}
+	if server.HealthCheckPort != 0 {
+		model.HealthCheckPort = types.Int64Value(server.HealthCheckPort)
+	}
+	if server.AgentCheck != "" {
+		model.AgentCheck = types.StringValue(server.AgentCheck)
+	}
+	if server.AgentAddr != "" {
+		model.AgentAddr = types.StringValue(server.AgentAddr)
+	}
+	if server.AgentPort != 0 {
+		model.AgentPort = types.Int64Value(server.AgentPort)
+	}
+	if server.AgentInter != 0 {
+		model.AgentInter = types.Int64Value(server.AgentInter)
+	}
+	if server.AgentSend != "" {
+		model.AgentSend = types.StringValue(server.AgentSend)
+	}
+	if server.Proto != "" {
+		model.Proto = types.StringValue(server.Proto)
+	}
+	if server.Alpn != "" {
+		model.Alpn = types.StringValue(server.Alpn)
+	}
+	if server.Sni != "" {
+		model.Sni = types.StringValue(server.Sni)
+	}
+	if server.VerifyHost != "" {
+		model.Verifyhost = types.StringValue(server.VerifyHost)
+	}
+	if server.Ciphers != "" {
+		model.Ciphers = types.StringValue(server.Ciphers)
+	}
+	if server.Ciphersuites != "" {
+		model.Ciphersuites = types.StringValue(server.Ciphersuites)
+	}
+	if server.SslReuse != "" {
+		model.SslReuse = types.StringValue(server.SslReuse)
+	}
+	if server.TlsTickets != "" {
+		model.TlsTickets = types.StringValue(server.TlsTickets)
+	}
+	if server.CheckSsl != "" {
+		model.CheckSsl = types.StringValue(server.CheckSsl)
+	}
+	if server.CheckSni != "" {
+		model.CheckSni = types.StringValue(server.CheckSni)
+	}
+	if server.CheckAlpn != "" {
+		model.CheckAlpn = types.StringValue(server.CheckAlpn)
+	}
+	if server.SendProxy != "" {
+		model.SendProxy = types.StringValue(server.SendProxy)
+	}
+	if server.SendProxyV2 != "" {
+		model.SendProxyV2 = types.StringValue(server.SendProxyV2)
+	}
+	if server.SendProxyV2Ssl != "" {
+		model.SendProxyV2Ssl = types.StringValue(server.SendProxyV2Ssl)
+	}
+	if server.SendProxyV2SslCn != "" {
+		model.SendProxyV2SslCn = types.StringValue(server.SendProxyV2SslCn)
+	}
+	if server.PoolLowConn != 0 {
+		model.PoolLowConn = types.Int64Value(server.PoolLowConn)
+	}
+	if server.PoolMaxConn != 0 {
+		model.PoolMaxConn = types.Int64Value(server.PoolMaxConn)
+	}
+	if server.PoolPurgeDelay != 0 {
+		model.PoolPurgeDelay = types.Int64Value(server.PoolPurgeDelay)
+	}
+	if server.Source != "" {
+		model.Source = types.StringValue(server.Source)
+	}
+	if server.Redir != "" {
+		model.Redir = types.StringValue(server.Redir)
+	}
+	if server.Stick != "" {
+		model.Stick = types.StringValue(server.Stick)
+	}
+	if server.Tfo != "" {
+		model.Tfo = types.StringValue(server.Tfo)
+	}
+	if server.LogProto != "" {
+		model.LogProto = types.StringValue(server.LogProto)
+	}
+	for _, option := range server.ProxyV2Options {
+		model.ProxyV2Options = append(model.ProxyV2Options, types.StringValue(option))
+	}
 	// HAProxy doesn't support server disabling - field ignored
 	// This field has been removed from the schema
 
@@ -216,6 +391,129 @@ func (o *StackOperations) convertServerModelToPayload(serverName string, server
 	if !server.Cookie.IsNull() && !server.Cookie.IsUnknown() {
 		payload.Cookie = server.Cookie.ValueString()
 	}
+	if !server.Maxqueue.IsNull() && !server.Maxqueue.IsUnknown() {
+		payload.Maxqueue = server.Maxqueue.ValueInt64()
+	}
+	if !server.Minconn.IsNull() && !server.Minconn.IsUnknown() {
+		payload.Minconn = server.Minconn.ValueInt64()
+	}
+	if !server.Slowstart.IsNull() && !server.Slowstart.IsUnknown() {
+		payload.Slowstart = server.Slowstart.ValueInt64()
+	}
+	if !server.InitAddr.IsNull() && !server.InitAddr.IsUnknown() {
+		payload.InitAddr = server.InitAddr.ValueString()
+	}
+	if !server.Maintenance.IsNull() && !server.Maintenance.IsUnknown() {
+		payload.Maintenance = server.Maintenance.ValueString()
+	}
+	if !server.Track.IsNull() && !server.Track.IsUnknown() {
+		payload.Track = server.Track.ValueString()
+	}
+	if !server.Observe.IsNull() && !server.Observe.IsUnknown() {
+		payload.Observe = server.Observe.ValueString()
+	}
+	if !server.ErrorLimit.IsNull() && !server.ErrorLimit.IsUnknown() {
+		payload.ErrorLimit = server.ErrorLimit.ValueInt64()
+	}
+	if !server.OnError.IsNull() && !server.OnError.IsUnknown() {
+		payload.OnError = server.OnError.ValueString()
+	}
+	if !server.OnMarkedDown.IsNull() && !server.OnMarkedDown.IsUnknown() {
+		payload.OnMarkedDown = server.OnMarkedDown.ValueString()
+	}
+	if !server.OnMarkedUp.IsNull() && !server.OnMarkedUp.IsUnknown() {
+		payload.OnMarkedUp = server.OnMarkedUp.ValueString()
+	}
+	if !server.HealthCheckPort.IsNull() && !server.HealthCheckPort.IsUnknown() {
+		payload.HealthCheckPort = server.HealthCheckPort.ValueInt64()
+	}
+	if !server.AgentCheck.IsNull() && !server.AgentCheck.IsUnknown() {
+		payload.AgentCheck = server.AgentCheck.ValueString()
+	}
+	if !server.AgentAddr.IsNull() && !server.AgentAddr.IsUnknown() {
+		payload.AgentAddr = server.AgentAddr.ValueString()
+	}
+	if !server.AgentPort.IsNull() && !server.AgentPort.IsUnknown() {
+		payload.AgentPort = server.AgentPort.ValueInt64()
+	}
+	if !server.AgentInter.IsNull() && !server.AgentInter.IsUnknown() {
+		payload.AgentInter = server.AgentInter.ValueInt64()
+	}
+	if !server.AgentSend.IsNull() && !server.AgentSend.IsUnknown() {
+		payload.AgentSend = server.AgentSend.ValueString()
+	}
+	if !server.Proto.IsNull() && !server.Proto.IsUnknown() {
+		payload.Proto = server.Proto.ValueString()
+	}
+	if !server.Alpn.IsNull() && !server.Alpn.IsUnknown() {
+		payload.Alpn = server.Alpn.ValueString()
+	}
+	if !server.Sni.IsNull() && !server.Sni.IsUnknown() {
+		payload.Sni = server.Sni.ValueString()
+	}
+	if !server.Verifyhost.IsNull() && !server.Verifyhost.IsUnknown() {
+		payload.VerifyHost = server.Verifyhost.ValueString()
+	}
+	if !server.Ciphers.IsNull() && !server.Ciphers.IsUnknown() {
+		payload.Ciphers = server.Ciphers.ValueString()
+	}
+	if !server.Ciphersuites.IsNull() && !server.Ciphersuites.IsUnknown() {
+		payload.Ciphersuites = server.Ciphersuites.ValueString()
+	}
+	if !server.SslReuse.IsNull() && !server.SslReuse.IsUnknown() {
+		payload.SslReuse = server.SslReuse.ValueString()
+	}
+	if !server.TlsTickets.IsNull() && !server.TlsTickets.IsUnknown() {
+		payload.TlsTickets = server.TlsTickets.ValueString()
+	}
+	if !server.CheckSsl.IsNull() && !server.CheckSsl.IsUnknown() {
+		payload.CheckSsl = server.CheckSsl.ValueString()
+	}
+	if !server.CheckSni.IsNull() && !server.CheckSni.IsUnknown() {
+		payload.CheckSni = server.CheckSni.ValueString()
+	}
+	if !server.CheckAlpn.IsNull() && !server.CheckAlpn.IsUnknown() {
+		payload.CheckAlpn = server.CheckAlpn.ValueString()
+	}
+	if !server.SendProxy.IsNull() && !server.SendProxy.IsUnknown() {
+		payload.SendProxy = server.SendProxy.ValueString()
+	}
+	if !server.SendProxyV2.IsNull() && !server.SendProxyV2.IsUnknown() {
+		payload.SendProxyV2 = server.SendProxyV2.ValueString()
+	}
+	if !server.SendProxyV2Ssl.IsNull() && !server.SendProxyV2Ssl.IsUnknown() {
+		payload.SendProxyV2Ssl = server.SendProxyV2Ssl.ValueString()
+	}
+	if !server.SendProxyV2SslCn.IsNull() && !server.SendProxyV2SslCn.IsUnknown() {
+		payload.SendProxyV2SslCn = server.SendProxyV2SslCn.ValueString()
+	}
+	if !server.PoolLowConn.IsNull() && !server.PoolLowConn.IsUnknown() {
+		payload.PoolLowConn = server.PoolLowConn.ValueInt64()
+	}
+	if !server.PoolMaxConn.IsNull() && !server.PoolMaxConn.IsUnknown() {
+		payload.PoolMaxConn = server.PoolMaxConn.ValueInt64()
+	}
+	if !server.PoolPurgeDelay.IsNull() && !server.PoolPurgeDelay.IsUnknown() {
+		payload.PoolPurgeDelay = server.PoolPurgeDelay.ValueInt64()
+	}
+	if !server.Source.IsNull() && !server.Source.IsUnknown() {
+		payload.Source = server.Source.ValueString()
+	}
+	if !server.Redir.IsNull() && !server.Redir.IsUnknown() {
+		payload.Redir = server.Redir.ValueString()
+	}
+	if !server.Stick.IsNull() && !server.Stick.IsUnknown() {
+		payload.Stick = server.Stick.ValueString()
+	}
+	if !server.Tfo.IsNull() && !server.Tfo.IsUnknown() {
+		payload.Tfo = server.Tfo.ValueString()
+	}
+	if !server.LogProto.IsNull() && !server.LogProto.IsUnknown() {
+		payload.LogProto = server.LogProto.ValueString()
+	}
+	for _, option := range server.ProxyV2Options {
+		payload.ProxyV2Options = append(payload.ProxyV2Options, option.ValueString())
+	}
 	// HAProxy doesn't support server disabling - field ignored
 	// We don't send it to HAProxy, but we allow it in the Terraform config
 	// for user convenience. It will always be read as false from HAProxy.
@@ -364,6 +662,11 @@ func (o *StackOperations) createSingle(ctx context.Context, req resource.CreateR
 func (o *StackOperations) createSingleInternal(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse, data *haproxyStackResourceModel) error {
 	tflog.Info(ctx, "Creating HAProxy stack")
 
+	// Store error pages before the transaction that references them
+	if err := o.storeErrorPages(ctx, data, nil); err != nil {
+		return fmt.Errorf("error storing error pages: %w", err)
+	}
+
 	// Begin a single transaction for all resources
 	tflog.Info(ctx, "Beginning single transaction for all resources")
 	transactionID, err := o.client.BeginTransaction()
@@ -440,6 +743,13 @@ func (o *StackOperations) createSingleInternal(ctx context.Context, req resource
 		}
 	}
 
+	// Create Backend Switching Rules AFTER ACLs (so they can reference existing ACLs)
+	if data.Frontend != nil && len(data.Frontend.BackendSwitchingRules) > 0 {
+		if err := o.backendSwitchingRuleManager.Create(ctx, transactionID, data.Frontend.Name.ValueString(), data.Frontend.BackendSwitchingRules); err != nil {
+			return fmt.Errorf("error creating backend switching rules: %w", err)
+		}
+	}
+
 	// Create Backend HTTP Request Rules AFTER ACLs (so they can reference existing ACLs)
 	if data.Backend != nil && data.Backend.HttpRequestRules != nil && len(data.Backend.HttpRequestRules) > 0 {
 		if err := o.httpRequestRuleManager.CreateHttpRequestRulesInTransaction(ctx, transactionID, "backend", data.Backend.Name.ValueString(), data.Backend.HttpRequestRules); err != nil {
@@ -454,6 +764,13 @@ func (o *StackOperations) createSingleInternal(ctx context.Context, req resource
 		}
 	}
 
+	// Create Frontend HTTP After-Response Rules AFTER HTTP Response Rules
+	if data.Frontend != nil && len(data.Frontend.HttpAfterResponseRules) > 0 {
+		if err := o.httpAfterResponseRuleManager.Create(ctx, transactionID, "frontend", data.Frontend.Name.ValueString(), data.Frontend.HttpAfterResponseRules); err != nil {
+			return fmt.Errorf("error creating frontend HTTP after-response rules: %w", err)
+		}
+	}
+
 	// Create Frontend TCP Request Rules AFTER HTTP Response Rules
 	if data.Frontend != nil && data.Frontend.TcpRequestRules != nil && len(data.Frontend.TcpRequestRules) > 0 {
 		tcpRequestRules := o.convertTcpRequestRulesToResourceModels(data.Frontend.TcpRequestRules, "frontend", data.Frontend.Name.ValueString())
@@ -469,6 +786,13 @@ func (o *StackOperations) createSingleInternal(ctx context.Context, req resource
 		}
 	}
 
+	// Create Backend HTTP After-Response Rules AFTER HTTP Response Rules
+	if data.Backend != nil && len(data.Backend.HttpAfterResponseRules) > 0 {
+		if err := o.httpAfterResponseRuleManager.Create(ctx, transactionID, "backend", data.Backend.Name.ValueString(), data.Backend.HttpAfterResponseRules); err != nil {
+			return fmt.Errorf("error creating backend HTTP after-response rules: %w", err)
+		}
+	}
+
 	// Create Backend TCP Request Rules AFTER HTTP Response Rules
 	if data.Backend != nil && data.Backend.TcpRequestRules != nil && len(data.Backend.TcpRequestRules) > 0 {
 		tcpRequestRules := o.convertTcpRequestRulesToResourceModels(data.Backend.TcpRequestRules, "backend", data.Backend.Name.ValueString())
@@ -485,6 +809,34 @@ func (o *StackOperations) createSingleInternal(ctx context.Context, req resource
 		}
 	}
 
+	// Create Backend Stick Rules AFTER TCP Response Rules
+	if data.Backend != nil && len(data.Backend.StickRules) > 0 {
+		if err := o.stickRuleManager.Create(ctx, transactionID, data.Backend.Name.ValueString(), data.Backend.StickRules); err != nil {
+			return fmt.Errorf("error creating backend stick rules: %w", err)
+		}
+	}
+
+	// Create Backend Server Switching Rules AFTER the servers and ACLs they reference
+	if data.Backend != nil && len(data.Backend.ServerSwitchingRules) > 0 {
+		if err := o.serverSwitchingRuleManager.Create(ctx, transactionID, data.Backend.Name.ValueString(), data.Backend.ServerSwitchingRules); err != nil {
+			return fmt.Errorf("error creating backend server switching rules: %w", err)
+		}
+	}
+
+	// Create Frontend Filters AFTER the rules
+	if data.Frontend != nil && len(data.Frontend.Filters) > 0 {
+		if err := o.filterManager.Create(ctx, transactionID, "frontend", data.Frontend.Name.ValueString(), data.Frontend.Filters); err != nil {
+			return fmt.Errorf("error creating frontend filters: %w", err)
+		}
+	}
+
+	// Create Backend Filters AFTER the rules
+	if data.Backend != nil && len(data.Backend.Filters) > 0 {
+		if err := o.filterManager.Create(ctx, transactionID, "backend", data.Backend.Name.ValueString(), data.Backend.Filters); err != nil {
+			return fmt.Errorf("error creating backend filters: %w", err)
+		}
+	}
+
 	// Create Backend HTTP Checks AFTER TCP Response Rules
 	if data.Backend != nil && data.Backend.Httpchecks != nil && len(data.Backend.Httpchecks) > 0 {
 		httpChecks := o.convertHttpchecksToResourceModels(data.Backend.Httpchecks, "backend", data.Backend.Name.ValueString())
@@ -524,10 +876,11 @@ func (o *StackOperations) Read(ctx context.Context, req resource.ReadRequest, re
 
 	// Read backend if specified
 	if data.Backend != nil {
-		_, err := o.backendManager.ReadBackend(ctx, data.Backend.Name.ValueString(), data.Backend)
+		backend, err := o.backendManager.ReadBackend(ctx, data.Backend.Name.ValueString(), data.Backend)
 		if err != nil {
 			return fmt.Errorf("error reading backend: %w", err)
 		}
+		refreshBackendBlocks(data.Backend, backend)
 	}
 
 	// Read servers if specified
@@ -547,12 +900,13 @@ func (o *StackOperations) Read(ctx context.Context, req resource.ReadRequest, re
 				"servers_found": len(servers),
 			})
 			// Convert servers to map format, preserving existing values for fields HAProxy doesn't return
-			if data.Backend.Servers == nil {
-				data.Backend.Servers = make(map[string]haproxyServerModel)
-			}
 			for _, server := range servers {
-				// Preserve existing values for fields HAProxy doesn't return
-				existingServer := data.Backend.Servers[server.Name]
+				// Servers not tracked by this stack belong to standalone haproxy_server
+				// resources attached to the same backend; leave them out of the stack state.
+				existingServer, managed := data.Backend.Servers[server.Name]
+				if !managed {
+					continue
+				}
 				newServer := o.convertServerPayloadToModel(server)
 
 				// Preserve user-configured values for fields HAProxy doesn't return
@@ -917,6 +1271,11 @@ func (o *StackOperations) Read(ctx context.Context, req resource.ReadRequest, re
 
 // Update performs the update operation for the haproxy_stack resource
 func (o *StackOperations) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse, data *haproxyStackResourceModel) error {
+	// Drain removed servers first; waiting for their sessions must not hold the lock
+	if err := o.drainRemovedServers(ctx, req, data); err != nil {
+		return fmt.Errorf("error draining removed servers: %w", err)
+	}
+
 	// Serialize all HAProxy operations to prevent transaction conflicts
 	globalTransactionMutex.Lock()
 	defer globalTransactionMutex.Unlock()
@@ -954,6 +1313,11 @@ func (o *StackOperations) updateSingleInternal(ctx context.Context, req resource
 	}
 	tflog.Info(ctx, "Updating HAProxy stack")
 
+	// Store error pages before the transaction that references them
+	if err := o.storeErrorPages(ctx, data, &state); err != nil {
+		return fmt.Errorf("error storing error pages: %w", err)
+	}
+
 	// Begin transaction for all updates
 	transactionID, err := o.client.BeginTransaction()
 	if err != nil {
@@ -1018,8 +1382,12 @@ func (o *StackOperations) updateSingleInternal(ctx context.Context, req resource
 			// Create a map of desired servers by name (data.Backend.Servers is already a map)
 			desiredServerMap := data.Backend.Servers
 
-			// Delete servers that are no longer in the desired state
+			// Delete servers that were managed by this stack but are no longer in the desired state.
+			// Servers the stack never owned (e.g. haproxy_server resources) are left untouched.
 			for serverName := range existingServerMap {
+				if _, managed := stateServers[serverName]; !managed {
+					continue
+				}
 				if _, exists := desiredServerMap[serverName]; !exists {
 					tflog.Info(ctx, "Deleting server", map[string]interface{}{"server_name": serverName})
 					if err = o.client.DeleteServerInTransaction(ctx, transactionID, "backend", data.Backend.Name.ValueString(), serverName); err != nil {
@@ -1089,7 +1457,7 @@ func (o *StackOperations) updateSingleInternal(ctx context.Context, req resource
 		bindsChanged := o.bindsChanged(ctx, data.Frontend.Binds, state.Frontend.Binds)
 		if bindsChanged {
 			tflog.Info(ctx, "Binds changed, updating", map[string]interface{}{"frontend_name": data.Frontend.Name.ValueString()})
-			if err = o.bindManager.UpdateBindsInTransaction(ctx, transactionID, "frontend", data.Frontend.Name.ValueString(), data.Frontend.Binds); err != nil {
+			if err = o.bindManager.UpdateBindsInTransaction(ctx, transactionID, "frontend", data.Frontend.Name.ValueString(), data.Frontend.Binds, state.Frontend.Binds); err != nil {
 				return fmt.Errorf("error updating binds: %w", err)
 			}
 		} else {
@@ -1097,123 +1465,171 @@ func (o *StackOperations) updateSingleInternal(ctx context.Context, req resource
 		}
 	}
 
-	// Update frontend ACLs only if they changed in the plan
-	if data.Frontend != nil && data.Frontend.Acls != nil && len(data.Frontend.Acls) > 0 {
-		// Check if frontend ACLs changed by comparing plan vs state
-		frontendACLsChanged := o.aclsChanged(ctx, data.Frontend.Acls, state.Frontend.Acls)
-		if frontendACLsChanged {
+	// Update frontend ACLs only if they changed in the plan. Only the ACLs in state are replaced,
+	// so ACLs added to the frontend outside the stack are kept.
+	if data.Frontend != nil {
+		var stateAcls []haproxyAclModel
+		if state.Frontend != nil {
+			stateAcls = state.Frontend.Acls
+		}
+		if o.aclsChanged(ctx, data.Frontend.Acls, stateAcls) {
 			tflog.Info(ctx, "Frontend ACLs changed, updating", map[string]interface{}{"frontend_name": data.Frontend.Name.ValueString()})
-			if err = o.aclManager.UpdateACLsInTransaction(ctx, transactionID, "frontend", data.Frontend.Name.ValueString(), data.Frontend.Acls); err != nil {
+			if err = o.aclManager.UpdateACLsInTransaction(ctx, transactionID, "frontend", data.Frontend.Name.ValueString(), data.Frontend.Acls, stateAcls); err != nil {
 				return fmt.Errorf("error updating frontend ACLs: %w", err)
 			}
 		} else {
 			tflog.Info(ctx, "Frontend ACLs unchanged, skipping update")
 		}
-	} else if data.Frontend != nil && state.Frontend != nil && state.Frontend.Acls != nil && len(state.Frontend.Acls) > 0 {
-		// Handle frontend ACLs deletion - plan has no ACLs but state does
-		tflog.Info(ctx, "Frontend ACLs removed, deleting", map[string]interface{}{"frontend_name": data.Frontend.Name.ValueString()})
-		if err = o.aclManager.DeleteACLsInTransaction(ctx, transactionID, "frontend", data.Frontend.Name.ValueString()); err != nil {
-			return fmt.Errorf("error deleting frontend ACLs: %w", err)
-		}
 	}
 
-	// Update backend ACLs only if they changed in the plan
-	if data.Backend != nil && data.Backend.Acls != nil && len(data.Backend.Acls) > 0 {
-		// Check if backend ACLs changed by comparing plan vs state
-		backendACLsChanged := o.aclsChanged(ctx, data.Backend.Acls, state.Backend.Acls)
-		if backendACLsChanged {
+	// Update backend ACLs only if they changed in the plan. Only the ACLs in state are replaced,
+	// so ACLs added to the backend outside the stack are kept.
+	if data.Backend != nil {
+		var stateAcls []haproxyAclModel
+		if state.Backend != nil {
+			stateAcls = state.Backend.Acls
+		}
+		if o.aclsChanged(ctx, data.Backend.Acls, stateAcls) {
 			tflog.Info(ctx, "Backend ACLs changed, updating", map[string]interface{}{"backend_name": data.Backend.Name.ValueString()})
-			if err = o.aclManager.UpdateACLsInTransaction(ctx, transactionID, "backend", data.Backend.Name.ValueString(), data.Backend.Acls); err != nil {
+			if err = o.aclManager.UpdateACLsInTransaction(ctx, transactionID, "backend", data.Backend.Name.ValueString(), data.Backend.Acls, stateAcls); err != nil {
 				return fmt.Errorf("error updating backend ACLs: %w", err)
 			}
 		} else {
 			tflog.Info(ctx, "Backend ACLs unchanged, skipping update")
 		}
-	} else if data.Backend != nil && state.Backend != nil && state.Backend.Acls != nil && len(state.Backend.Acls) > 0 {
-		// Handle backend ACLs deletion - plan has no ACLs but state does
-		tflog.Info(ctx, "Backend ACLs removed, deleting", map[string]interface{}{"backend_name": data.Backend.Name.ValueString()})
-		if err = o.aclManager.DeleteACLsInTransaction(ctx, transactionID, "backend", data.Backend.Name.ValueString()); err != nil {
-			return fmt.Errorf("error deleting backend ACLs: %w", err)
-		}
 	}
 
-	// Update HTTP Request Rules only if they changed in the plan
-	if data.Frontend != nil && data.Frontend.HttpRequestRules != nil && len(data.Frontend.HttpRequestRules) > 0 {
-		// Check if HTTP Request Rules changed by comparing plan vs state
-		httpRequestRulesChanged := o.httpRequestRulesChanged(ctx, data.Frontend.HttpRequestRules, state.Frontend.HttpRequestRules)
-		if httpRequestRulesChanged {
+	// Update HTTP Request Rules only if they changed in the plan. Only the rules in state are replaced,
+	// so rules added to the frontend outside the stack are kept.
+	if data.Frontend != nil {
+		var stateRules []haproxyHttpRequestRuleModel
+		if state.Frontend != nil {
+			stateRules = state.Frontend.HttpRequestRules
+		}
+		if o.httpRequestRulesChanged(ctx, data.Frontend.HttpRequestRules, stateRules) {
 			tflog.Info(ctx, "HTTP request rules changed, updating", map[string]interface{}{"frontend_name": data.Frontend.Name.ValueString()})
-			if err = o.httpRequestRuleManager.UpdateHttpRequestRulesInTransaction(ctx, transactionID, "frontend", data.Frontend.Name.ValueString(), data.Frontend.HttpRequestRules); err != nil {
+			if err = o.httpRequestRuleManager.UpdateHttpRequestRulesInTransaction(ctx, transactionID, "frontend", data.Frontend.Name.ValueString(), data.Frontend.HttpRequestRules, stateRules); err != nil {
 				return fmt.Errorf("error updating HTTP request rules: %w", err)
 			}
 		} else {
 			tflog.Info(ctx, "HTTP request rules unchanged, skipping update")
 		}
-	} else if data.Frontend != nil && state.Frontend != nil && state.Frontend.HttpRequestRules != nil && len(state.Frontend.HttpRequestRules) > 0 {
-		// Handle HTTP request rules deletion - plan has no rules but state does
-		tflog.Info(ctx, "HTTP request rules removed, deleting", map[string]interface{}{"frontend_name": data.Frontend.Name.ValueString()})
-		if err = o.httpRequestRuleManager.DeleteHttpRequestRulesInTransaction(ctx, transactionID, "frontend", data.Frontend.Name.ValueString()); err != nil {
-			return fmt.Errorf("error deleting HTTP request rules: %w", err)
+	}
+
+	// Update Backend Switching Rules only if they changed in the plan
+	if data.Frontend != nil && len(data.Frontend.BackendSwitchingRules) > 0 {
+		var stateRules []haproxyBackendSwitchingRuleModel
+		if state.Frontend != nil {
+			stateRules = state.Frontend.BackendSwitchingRules
+		}
+		if o.backendSwitchingRulesChanged(ctx, data.Frontend.BackendSwitchingRules, stateRules) {
+			tflog.Info(ctx, "Backend switching rules changed, updating", map[string]interface{}{"frontend_name": data.Frontend.Name.ValueString()})
+			if err = o.backendSwitchingRuleManager.Update(ctx, transactionID, data.Frontend.Name.ValueString(), data.Frontend.BackendSwitchingRules); err != nil {
+				return fmt.Errorf("error updating backend switching rules: %w", err)
+			}
+		} else {
+			tflog.Info(ctx, "Backend switching rules unchanged, skipping update")
+		}
+	} else if data.Frontend != nil && state.Frontend != nil && len(state.Frontend.BackendSwitchingRules) > 0 {
+		// Handle backend switching rules deletion - plan has no rules but state does
+		tflog.Info(ctx, "Backend switching rules removed, deleting", map[string]interface{}{"frontend_name": data.Frontend.Name.ValueString()})
+		if err = o.backendSwitchingRuleManager.Delete(ctx, transactionID, data.Frontend.Name.ValueString()); err != nil {
+			return fmt.Errorf("error deleting backend switching rules: %w", err)
 		}
 	}
 
-	// Update Backend HTTP Request Rules only if they changed in the plan
-	if data.Backend != nil && data.Backend.HttpRequestRules != nil && len(data.Backend.HttpRequestRules) > 0 {
-		// Check if HTTP Request Rules changed by comparing plan vs state
-		httpRequestRulesChanged := o.httpRequestRulesChanged(ctx, data.Backend.HttpRequestRules, state.Backend.HttpRequestRules)
-		if httpRequestRulesChanged {
+	// Update Backend HTTP Request Rules only if they changed in the plan. Only the rules in state are replaced,
+	// so rules added to the backend outside the stack are kept.
+	if data.Backend != nil {
+		var stateRules []haproxyHttpRequestRuleModel
+		if state.Backend != nil {
+			stateRules = state.Backend.HttpRequestRules
+		}
+		if o.httpRequestRulesChanged(ctx, data.Backend.HttpRequestRules, stateRules) {
 			tflog.Info(ctx, "Backend HTTP request rules changed, updating", map[string]interface{}{"backend_name": data.Backend.Name.ValueString()})
-			if err = o.httpRequestRuleManager.UpdateHttpRequestRulesInTransaction(ctx, transactionID, "backend", data.Backend.Name.ValueString(), data.Backend.HttpRequestRules); err != nil {
+			if err = o.httpRequestRuleManager.UpdateHttpRequestRulesInTransaction(ctx, transactionID, "backend", data.Backend.Name.ValueString(), data.Backend.HttpRequestRules, stateRules); err != nil {
 				return fmt.Errorf("error updating backend HTTP request rules: %w", err)
 			}
 		} else {
 			tflog.Info(ctx, "Backend HTTP request rules unchanged, skipping update")
 		}
-	} else if data.Backend != nil && state.Backend != nil && state.Backend.HttpRequestRules != nil && len(state.Backend.HttpRequestRules) > 0 {
-		// Handle backend HTTP request rules deletion - plan has no rules but state does
-		tflog.Info(ctx, "Backend HTTP request rules removed, deleting", map[string]interface{}{"backend_name": data.Backend.Name.ValueString()})
-		if err = o.httpRequestRuleManager.DeleteHttpRequestRulesInTransaction(ctx, transactionID, "backend", data.Backend.Name.ValueString()); err != nil {
-			return fmt.Errorf("error deleting backend HTTP request rules: %w", err)
-		}
 	}
 
-	// Update Frontend HTTP Response Rules only if they changed in the plan
-	if data.Frontend != nil && data.Frontend.HttpResponseRules != nil && len(data.Frontend.HttpResponseRules) > 0 {
-		// Check if HTTP Response Rules changed by comparing plan vs state
-		httpResponseRulesChanged := o.httpResponseRulesChanged(ctx, data.Frontend.HttpResponseRules, state.Frontend.HttpResponseRules)
-		if httpResponseRulesChanged {
+	// Update Frontend HTTP Response Rules only if they changed in the plan. Only the rules in state are replaced,
+	// so rules added to the frontend outside the stack are kept.
+	if data.Frontend != nil {
+		var stateRules []haproxyHttpResponseRuleModel
+		if state.Frontend != nil {
+			stateRules = state.Frontend.HttpResponseRules
+		}
+		if o.httpResponseRulesChanged(ctx, data.Frontend.HttpResponseRules, stateRules) {
 			tflog.Info(ctx, "Frontend HTTP response rules changed, updating", map[string]interface{}{"frontend_name": data.Frontend.Name.ValueString()})
-			if err = o.httpResponseRuleManager.UpdateHttpResponseRulesInTransaction(ctx, transactionID, "frontend", data.Frontend.Name.ValueString(), data.Frontend.HttpResponseRules); err != nil {
+			if err = o.httpResponseRuleManager.UpdateHttpResponseRulesInTransaction(ctx, transactionID, "frontend", data.Frontend.Name.ValueString(), data.Frontend.HttpResponseRules, stateRules); err != nil {
 				return fmt.Errorf("error updating frontend HTTP response rules: %w", err)
 			}
 		} else {
 			tflog.Info(ctx, "Frontend HTTP response rules unchanged, skipping update")
 		}
-	} else if data.Frontend != nil && state.Frontend != nil && state.Frontend.HttpResponseRules != nil && len(state.Frontend.HttpResponseRules) > 0 {
-		// Handle frontend HTTP response rules deletion - plan has no rules but state does
-		tflog.Info(ctx, "Frontend HTTP response rules removed, deleting", map[string]interface{}{"frontend_name": data.Frontend.Name.ValueString()})
-		if err = o.httpResponseRuleManager.DeleteHttpResponseRulesInTransaction(ctx, transactionID, "frontend", data.Frontend.Name.ValueString()); err != nil {
-			return fmt.Errorf("error deleting frontend HTTP response rules: %w", err)
-		}
 	}
 
-	// Update Backend HTTP Response Rules only if they changed in the plan
-	if data.Backend != nil && data.Backend.HttpResponseRules != nil && len(data.Backend.HttpResponseRules) > 0 {
-		// Check if HTTP Response Rules changed by comparing plan vs state
-		httpResponseRulesChanged := o.httpResponseRulesChanged(ctx, data.Backend.HttpResponseRules, state.Backend.HttpResponseRules)
-		if httpResponseRulesChanged {
+	// Update Backend HTTP Response Rules only if they changed in the plan. Only the rules in state are replaced,
+	// so rules added to the backend outside the stack are kept.
+	if data.Backend != nil {
+		var stateRules []haproxyHttpResponseRuleModel
+		if state.Backend != nil {
+			stateRules = state.Backend.HttpResponseRules
+		}
+		if o.httpResponseRulesChanged(ctx, data.Backend.HttpResponseRules, stateRules) {
 			tflog.Info(ctx, "Backend HTTP response rules changed, updating", map[string]interface{}{"backend_name": data.Backend.Name.ValueString()})
-			if err = o.httpResponseRuleManager.UpdateHttpResponseRulesInTransaction(ctx, transactionID, "backend", data.Backend.Name.ValueString(), data.Backend.HttpResponseRules); err != nil {
+			if err = o.httpResponseRuleManager.UpdateHttpResponseRulesInTransaction(ctx, transactionID, "backend", data.Backend.Name.ValueString(), data.Backend.HttpResponseRules, stateRules); err != nil {
 				return fmt.Errorf("error updating backend HTTP response rules: %w", err)
 			}
 		} else {
 			tflog.Info(ctx, "Backend HTTP response rules unchanged, skipping update")
 		}
-	} else if data.Backend != nil && state.Backend != nil && state.Backend.HttpResponseRules != nil && len(state.Backend.HttpResponseRules) > 0 {
-		// Handle backend HTTP response rules deletion - plan has no rules but state does
-		tflog.Info(ctx, "Backend HTTP response rules removed, deleting", map[string]interface{}{"backend_name": data.Backend.Name.ValueString()})
-		if err = o.httpResponseRuleManager.DeleteHttpResponseRulesInTransaction(ctx, transactionID, "backend", data.Backend.Name.ValueString()); err != nil {
-			return fmt.Errorf("error deleting backend HTTP response rules: %w", err)
+	}
+
+	// Update Frontend HTTP After-Response Rules only if they changed in the plan
+	if data.Frontend != nil && len(data.Frontend.HttpAfterResponseRules) > 0 {
+		var stateRules []haproxyHttpAfterResponseRuleModel
+		if state.Frontend != nil {
+			stateRules = state.Frontend.HttpAfterResponseRules
+		}
+		if o.httpAfterResponseRulesChanged(ctx, data.Frontend.HttpAfterResponseRules, stateRules) {
+			tflog.Info(ctx, "Frontend HTTP after-response rules changed, updating", map[string]interface{}{"frontend_name": data.Frontend.Name.ValueString()})
+			if err = o.httpAfterResponseRuleManager.Update(ctx, transactionID, "frontend", data.Frontend.Name.ValueString(), data.Frontend.HttpAfterResponseRules); err != nil {
+				return fmt.Errorf("error updating frontend HTTP after-response rules: %w", err)
+			}
+		} else {
+			tflog.Info(ctx, "Frontend HTTP after-response rules unchanged, skipping update")
+		}
+	} else if data.Frontend != nil && state.Frontend != nil && len(state.Frontend.HttpAfterResponseRules) > 0 {
+		// Handle frontend HTTP after-response rules deletion - plan has no rules but state does
+		tflog.Info(ctx, "Frontend HTTP after-response rules removed, deleting", map[string]interface{}{"frontend_name": data.Frontend.Name.ValueString()})
+		if err = o.httpAfterResponseRuleManager.Delete(ctx, transactionID, "frontend", data.Frontend.Name.ValueString()); err != nil {
+			return fmt.Errorf("error deleting frontend HTTP after-response rules: %w", err)
+		}
+	}
+
+	// Update Backend HTTP After-Response Rules only if they changed in the plan
+	if data.Backend != nil && len(data.Backend.HttpAfterResponseRules) > 0 {
+		var stateRules []haproxyHttpAfterResponseRuleModel
+		if state.Backend != nil {
+			stateRules = state.Backend.HttpAfterResponseRules
+		}
+		if o.httpAfterResponseRulesChanged(ctx, data.Backend.HttpAfterResponseRules, stateRules) {
+			tflog.Info(ctx, "Backend HTTP after-response rules changed, updating", map[string]interface{}{"backend_name": data.Backend.Name.ValueString()})
+			if err = o.httpAfterResponseRuleManager.Update(ctx, transactionID, "backend", data.Backend.Name.ValueString(), data.Backend.HttpAfterResponseRules); err != nil {
+				return fmt.Errorf("error updating backend HTTP after-response rules: %w", err)
+			}
+		} else {
+			tflog.Info(ctx, "Backend HTTP after-response rules unchanged, skipping update")
+		}
+	} else if data.Backend != nil && state.Backend != nil && len(state.Backend.HttpAfterResponseRules) > 0 {
+		// Handle backend HTTP after-response rules deletion - plan has no rules but state does
+		tflog.Info(ctx, "Backend HTTP after-response rules removed, deleting", map[string]interface{}{"backend_name": data.Backend.Name.ValueString()})
+		if err = o.httpAfterResponseRuleManager.Delete(ctx, transactionID, "backend", data.Backend.Name.ValueString()); err != nil {
+			return fmt.Errorf("error deleting backend HTTP after-response rules: %w", err)
 		}
 	}
 
@@ -1280,6 +1696,94 @@ func (o *StackOperations) updateSingleInternal(ctx context.Context, req resource
 		}
 	}
 
+	// Update Frontend Filters only if they changed in the plan
+	if data.Frontend != nil && len(data.Frontend.Filters) > 0 {
+		var stateFilters []haproxyFilterModel
+		if state.Frontend != nil {
+			stateFilters = state.Frontend.Filters
+		}
+		if o.filtersChanged(ctx, data.Frontend.Filters, stateFilters) {
+			tflog.Info(ctx, "Frontend filters changed, updating", map[string]interface{}{"frontend_name": data.Frontend.Name.ValueString()})
+			if err = o.filterManager.Update(ctx, transactionID, "frontend", data.Frontend.Name.ValueString(), data.Frontend.Filters); err != nil {
+				return fmt.Errorf("error updating frontend filters: %w", err)
+			}
+		} else {
+			tflog.Info(ctx, "Frontend filters unchanged, skipping update")
+		}
+	} else if data.Frontend != nil && state.Frontend != nil && len(state.Frontend.Filters) > 0 {
+		// Handle frontend filters deletion - plan has no filters but state does
+		tflog.Info(ctx, "Frontend filters removed, deleting", map[string]interface{}{"frontend_name": data.Frontend.Name.ValueString()})
+		if err = o.filterManager.Delete(ctx, transactionID, "frontend", data.Frontend.Name.ValueString()); err != nil {
+			return fmt.Errorf("error deleting frontend filters: %w", err)
+		}
+	}
+
+	// Update Backend Filters only if they changed in the plan
+	if data.Backend != nil && len(data.Backend.Filters) > 0 {
+		var stateFilters []haproxyFilterModel
+		if state.Backend != nil {
+			stateFilters = state.Backend.Filters
+		}
+		if o.filtersChanged(ctx, data.Backend.Filters, stateFilters) {
+			tflog.Info(ctx, "Backend filters changed, updating", map[string]interface{}{"backend_name": data.Backend.Name.ValueString()})
+			if err = o.filterManager.Update(ctx, transactionID, "backend", data.Backend.Name.ValueString(), data.Backend.Filters); err != nil {
+				return fmt.Errorf("error updating backend filters: %w", err)
+			}
+		} else {
+			tflog.Info(ctx, "Backend filters unchanged, skipping update")
+		}
+	} else if data.Backend != nil && state.Backend != nil && len(state.Backend.Filters) > 0 {
+		// Handle backend filters deletion - plan has no filters but state does
+		tflog.Info(ctx, "Backend filters removed, deleting", map[string]interface{}{"backend_name": data.Backend.Name.ValueString()})
+		if err = o.filterManager.Delete(ctx, transactionID, "backend", data.Backend.Name.ValueString()); err != nil {
+			return fmt.Errorf("error deleting backend filters: %w", err)
+		}
+	}
+
+	// Update Backend Stick Rules only if they changed in the plan
+	if data.Backend != nil && len(data.Backend.StickRules) > 0 {
+		var stateRules []haproxyStickRuleModel
+		if state.Backend != nil {
+			stateRules = state.Backend.StickRules
+		}
+		if o.stickRulesChanged(ctx, data.Backend.StickRules, stateRules) {
+			tflog.Info(ctx, "Backend stick rules changed, updating", map[string]interface{}{"backend_name": data.Backend.Name.ValueString()})
+			if err = o.stickRuleManager.Update(ctx, transactionID, data.Backend.Name.ValueString(), data.Backend.StickRules); err != nil {
+				return fmt.Errorf("error updating backend stick rules: %w", err)
+			}
+		} else {
+			tflog.Info(ctx, "Backend stick rules unchanged, skipping update")
+		}
+	} else if data.Backend != nil && state.Backend != nil && len(state.Backend.StickRules) > 0 {
+		// Handle backend stick rules deletion - plan has no rules but state does
+		tflog.Info(ctx, "Backend stick rules removed, deleting", map[string]interface{}{"backend_name": data.Backend.Name.ValueString()})
+		if err = o.stickRuleManager.Delete(ctx, transactionID, data.Backend.Name.ValueString()); err != nil {
+			return fmt.Errorf("error deleting backend stick rules: %w", err)
+		}
+	}
+
+	// Update Backend Server Switching Rules only if they changed in the plan
+	if data.Backend != nil && len(data.Backend.ServerSwitchingRules) > 0 {
+		var stateRules []haproxyServerSwitchingRuleModel
+		if state.Backend != nil {
+			stateRules = state.Backend.ServerSwitchingRules
+		}
+		if o.serverSwitchingRulesChanged(ctx, data.Backend.ServerSwitchingRules, stateRules) {
+			tflog.Info(ctx, "Backend server switching rules changed, updating", map[string]interface{}{"backend_name": data.Backend.Name.ValueString()})
+			if err = o.serverSwitchingRuleManager.Update(ctx, transactionID, data.Backend.Name.ValueString(), data.Backend.ServerSwitchingRules); err != nil {
+				return fmt.Errorf("error updating backend server switching rules: %w", err)
+			}
+		} else {
+			tflog.Info(ctx, "Backend server switching rules unchanged, skipping update")
+		}
+	} else if data.Backend != nil && state.Backend != nil && len(state.Backend.ServerSwitchingRules) > 0 {
+		// Handle backend server switching rules deletion - plan has no rules but state does
+		tflog.Info(ctx, "Backend server switching rules removed, deleting", map[string]interface{}{"backend_name": data.Backend.Name.ValueString()})
+		if err = o.serverSwitchingRuleManager.Delete(ctx, transactionID, data.Backend.Name.ValueString()); err != nil {
+			return fmt.Errorf("error deleting backend server switching rules: %w", err)
+		}
+	}
+
 	// Update Backend HTTP Checks only if they changed in the plan
 	if data.Backend != nil && data.Backend.Httpchecks != nil && len(data.Backend.Httpchecks) > 0 {
 		// Check if HTTP Checks changed by comparing plan vs state
@@ -1367,6 +1871,9 @@ func (o *StackOperations) updateSingleInternal(ctx context.Context, req resource
 
 	// Clear the error so defer doesn't rollback
 	err = nil
+
+	// Remove error pages that are no longer referenced
+	o.cleanupErrorPages(ctx, &state, data)
 	tflog.Info(ctx, "HAProxy stack updated successfully")
 	return nil
 }
@@ -1396,7 +1903,14 @@ func (o *StackOperations) httpRequestRulesChanged(ctx context.Context, planRules
 			planRule.HdrName.ValueString() != stateRule.HdrName.ValueString() ||
 			planRule.HdrFormat.ValueString() != stateRule.HdrFormat.ValueString() ||
 			planRule.RedirType.ValueString() != stateRule.RedirType.ValueString() ||
-			planRule.RedirValue.ValueString() != stateRule.RedirValue.ValueString() {
+			planRule.RedirValue.ValueString() != stateRule.RedirValue.ValueString() ||
+			planRule.MapFile.ValueString() != stateRule.MapFile.ValueString() ||
+			planRule.MapKeyfmt.ValueString() != stateRule.MapKeyfmt.ValueString() ||
+			planRule.MapValuefmt.ValueString() != stateRule.MapValuefmt.ValueString() ||
+			planRule.VarName.ValueString() != stateRule.VarName.ValueString() ||
+			planRule.VarScope.ValueString() != stateRule.VarScope.ValueString() ||
+			planRule.VarExpr.ValueString() != stateRule.VarExpr.ValueString() ||
+			planRule.VarFormat.ValueString() != stateRule.VarFormat.ValueString() {
 			tflog.Info(ctx, "HTTP request rule changed", map[string]interface{}{
 				"rule_index": i,
 				"plan_type":  planRule.Type.ValueString(),
@@ -1409,6 +1923,77 @@ func (o *StackOperations) httpRequestRulesChanged(ctx context.Context, planRules
 	return false
 }
 
+// backendSwitchingRulesChanged compares plan vs state backend switching rules to detect changes
+func (o *StackOperations) backendSwitchingRulesChanged(ctx context.Context, planRules []haproxyBackendSwitchingRuleModel, stateRules []haproxyBackendSwitchingRuleModel) bool {
+	// If counts are different, there's definitely a change
+	if len(planRules) != len(stateRules) {
+		tflog.Info(ctx, "Backend switching rules count changed", map[string]interface{}{
+			"plan_count":  len(planRules),
+			"state_count": len(stateRules),
+		})
+		return true
+	}
+
+	// Compare each rule; the order is significant
+	for i, planRule := range planRules {
+		stateRule := stateRules[i]
+		if planRule.Name.ValueString() != stateRule.Name.ValueString() ||
+			planRule.Cond.ValueString() != stateRule.Cond.ValueString() ||
+			planRule.CondTest.ValueString() != stateRule.CondTest.ValueString() {
+			tflog.Info(ctx, "Backend switching rule changed", map[string]interface{}{
+				"rule_index":    i,
+				"plan_backend":  planRule.Name.ValueString(),
+				"state_backend": stateRule.Name.ValueString(),
+			})
+			return true
+		}
+	}
+
+	return false
+}
+
+// httpAfterResponseRulesChanged compares plan vs state HTTP after-response rules to detect changes
+func (o *StackOperations) httpAfterResponseRulesChanged(ctx context.Context, planRules []haproxyHttpAfterResponseRuleModel, stateRules []haproxyHttpAfterResponseRuleModel) bool {
+	// If counts are different, there's definitely a change
+	if len(planRules) != len(stateRules) {
+		tflog.Info(ctx, "HTTP after-response rules count changed", map[string]interface{}{
+			"plan_count":  len(planRules),
+			"state_count": len(stateRules),
+		})
+		return true
+	}
+
+	// Compare each rule; the order is significant
+	for i, planRule := range planRules {
+		stateRule := stateRules[i]
+		if planRule.Type.ValueString() != stateRule.Type.ValueString() ||
+			planRule.Cond.ValueString() != stateRule.Cond.ValueString() ||
+			planRule.CondTest.ValueString() != stateRule.CondTest.ValueString() ||
+			planRule.HdrName.ValueString() != stateRule.HdrName.ValueString() ||
+			planRule.HdrFormat.ValueString() != stateRule.HdrFormat.ValueString() ||
+			planRule.HdrMatch.ValueString() != stateRule.HdrMatch.ValueString() ||
+			planRule.Status.ValueInt64() != stateRule.Status.ValueInt64() ||
+			planRule.StatusReason.ValueString() != stateRule.StatusReason.ValueString() ||
+			planRule.VarName.ValueString() != stateRule.VarName.ValueString() ||
+			planRule.VarScope.ValueString() != stateRule.VarScope.ValueString() ||
+			planRule.VarExpr.ValueString() != stateRule.VarExpr.ValueString() ||
+			planRule.MapFile.ValueString() != stateRule.MapFile.ValueString() ||
+			planRule.MapKeyfmt.ValueString() != stateRule.MapKeyfmt.ValueString() ||
+			planRule.MapValuefmt.ValueString() != stateRule.MapValuefmt.ValueString() ||
+			planRule.LogLevel.ValueString() != stateRule.LogLevel.ValueString() ||
+			planRule.StrictMode.ValueString() != stateRule.StrictMode.ValueString() {
+			tflog.Info(ctx, "HTTP after-response rule changed", map[string]interface{}{
+				"rule_index": i,
+				"plan_type":  planRule.Type.ValueString(),
+				"state_type": stateRule.Type.ValueString(),
+			})
+			return true
+		}
+	}
+
+	return false
+}
+
 // httpResponseRulesChanged compares plan vs state HTTP response rules to detect changes
 func (o *StackOperations) httpResponseRulesChanged(ctx context.Context, planRules []haproxyHttpResponseRuleModel, stateRules []haproxyHttpResponseRuleModel) bool {
 	// If counts are different, there's definitely a change
@@ -1518,7 +2103,8 @@ func (o *StackOperations) frontendChanged(ctx context.Context, planFrontend *hap
 		planFrontend.TcpUserTimeout.ValueInt64() != stateFrontend.TcpUserTimeout.ValueInt64() ||
 		planFrontend.Tfo.ValueBool() != stateFrontend.Tfo.ValueBool() ||
 		planFrontend.V4v6.ValueBool() != stateFrontend.V4v6.ValueBool() ||
-		planFrontend.V6only.ValueBool() != stateFrontend.V6only.ValueBool() {
+		planFrontend.V6only.ValueBool() != stateFrontend.V6only.ValueBool() ||
+		planFrontend.Defaults.ValueString() != stateFrontend.Defaults.ValueString() {
 		tflog.Info(ctx, "Frontend basic fields changed", map[string]interface{}{
 			"plan_name":  planFrontend.Name.ValueString(),
 			"state_name": stateFrontend.Name.ValueString(),
@@ -1571,6 +2157,15 @@ func (o *StackOperations) frontendChanged(ctx context.Context, planFrontend *hap
 		return true
 	}
 
+	// Compare HttpAfterResponseRules field
+	if o.httpAfterResponseRulesChanged(ctx, planFrontend.HttpAfterResponseRules, stateFrontend.HttpAfterResponseRules) {
+		tflog.Info(ctx, "Frontend HttpAfterResponseRules changed", map[string]interface{}{
+			"plan_name":  planFrontend.Name.ValueString(),
+			"state_name": stateFrontend.Name.ValueString(),
+		})
+		return true
+	}
+
 	// Compare TcpRequestRules field
 	if o.tcpRequestRuleChanged(ctx, planFrontend.TcpRequestRules, stateFrontend.TcpRequestRules) {
 		tflog.Info(ctx, "Frontend TcpRequestRules changed", map[string]interface{}{
@@ -1580,6 +2175,42 @@ func (o *StackOperations) frontendChanged(ctx context.Context, planFrontend *hap
 		return true
 	}
 
+	// Compare BackendSwitchingRules field
+	if o.backendSwitchingRulesChanged(ctx, planFrontend.BackendSwitchingRules, stateFrontend.BackendSwitchingRules) {
+		tflog.Info(ctx, "Frontend BackendSwitchingRules changed", map[string]interface{}{
+			"plan_name":  planFrontend.Name.ValueString(),
+			"state_name": stateFrontend.Name.ValueString(),
+		})
+		return true
+	}
+
+	// Compare Compression field
+	if o.compressionChanged(planFrontend.Compression, stateFrontend.Compression) {
+		tflog.Info(ctx, "Frontend Compression changed", map[string]interface{}{
+			"plan_name":  planFrontend.Name.ValueString(),
+			"state_name": stateFrontend.Name.ValueString(),
+		})
+		return true
+	}
+
+	// Compare Filters field
+	if o.filtersChanged(ctx, planFrontend.Filters, stateFrontend.Filters) {
+		tflog.Info(ctx, "Frontend Filters changed", map[string]interface{}{
+			"plan_name":  planFrontend.Name.ValueString(),
+			"state_name": stateFrontend.Name.ValueString(),
+		})
+		return true
+	}
+
+	// Compare ErrorFiles field
+	if o.errorFilesChanged(planFrontend.ErrorFiles, stateFrontend.ErrorFiles) {
+		tflog.Info(ctx, "Frontend ErrorFiles changed", map[string]interface{}{
+			"plan_name":  planFrontend.Name.ValueString(),
+			"state_name": stateFrontend.Name.ValueString(),
+		})
+		return true
+	}
+
 	// Compare StatsOptions field
 	if o.statsOptionsChanged(ctx, planFrontend.StatsOptions, stateFrontend.StatsOptions) {
 		tflog.Info(ctx, "Frontend StatsOptions changed", map[string]interface{}{
@@ -1678,7 +2309,8 @@ func (o *StackOperations) backendChanged(ctx context.Context, planBackend *hapro
 		planBackend.TunnelTimeout.ValueInt64() != stateBackend.TunnelTimeout.ValueInt64() ||
 		planBackend.TarpitTimeout.ValueInt64() != stateBackend.TarpitTimeout.ValueInt64() ||
 		planBackend.Checkcache.ValueString() != stateBackend.Checkcache.ValueString() ||
-		planBackend.Retries.ValueInt64() != stateBackend.Retries.ValueInt64() {
+		planBackend.Retries.ValueInt64() != stateBackend.Retries.ValueInt64() ||
+		planBackend.Defaults.ValueString() != stateBackend.Defaults.ValueString() {
 		tflog.Info(ctx, "Backend basic fields changed", map[string]interface{}{
 			"plan_name":  planBackend.Name.ValueString(),
 			"state_name": stateBackend.Name.ValueString(),
@@ -1758,6 +2390,15 @@ func (o *StackOperations) backendChanged(ctx context.Context, planBackend *hapro
 		return true
 	}
 
+	// Compare HttpAfterResponseRules field
+	if o.httpAfterResponseRulesChanged(ctx, planBackend.HttpAfterResponseRules, stateBackend.HttpAfterResponseRules) {
+		tflog.Info(ctx, "Backend HttpAfterResponseRules changed", map[string]interface{}{
+			"plan_name":  planBackend.Name.ValueString(),
+			"state_name": stateBackend.Name.ValueString(),
+		})
+		return true
+	}
+
 	// Compare TcpRequestRules field
 	if o.tcpRequestRuleChanged(ctx, planBackend.TcpRequestRules, stateBackend.TcpRequestRules) {
 		tflog.Info(ctx, "Backend TcpRequestRule changed", map[string]interface{}{
@@ -1776,6 +2417,24 @@ func (o *StackOperations) backendChanged(ctx context.Context, planBackend *hapro
 		return true
 	}
 
+	// Compare StickRules field
+	if o.stickRulesChanged(ctx, planBackend.StickRules, stateBackend.StickRules) {
+		tflog.Info(ctx, "Backend StickRules changed", map[string]interface{}{
+			"plan_name":  planBackend.Name.ValueString(),
+			"state_name": stateBackend.Name.ValueString(),
+		})
+		return true
+	}
+
+	// Compare ServerSwitchingRules field
+	if o.serverSwitchingRulesChanged(ctx, planBackend.ServerSwitchingRules, stateBackend.ServerSwitchingRules) {
+		tflog.Info(ctx, "Backend ServerSwitchingRules changed", map[string]interface{}{
+			"plan_name":  planBackend.Name.ValueString(),
+			"state_name": stateBackend.Name.ValueString(),
+		})
+		return true
+	}
+
 	// Compare DefaultServer field
 	if o.defaultServerChanged(ctx, planBackend.DefaultServer, stateBackend.DefaultServer) {
 		tflog.Info(ctx, "Backend DefaultServer changed", map[string]interface{}{
@@ -1794,6 +2453,42 @@ func (o *StackOperations) backendChanged(ctx context.Context, planBackend *hapro
 		return true
 	}
 
+	// Compare EmailAlert field
+	if o.emailAlertChanged(planBackend.EmailAlert, stateBackend.EmailAlert) {
+		tflog.Info(ctx, "Backend EmailAlert changed", map[string]interface{}{
+			"plan_name":  planBackend.Name.ValueString(),
+			"state_name": stateBackend.Name.ValueString(),
+		})
+		return true
+	}
+
+	// Compare Compression field
+	if o.compressionChanged(planBackend.Compression, stateBackend.Compression) {
+		tflog.Info(ctx, "Backend Compression changed", map[string]interface{}{
+			"plan_name":  planBackend.Name.ValueString(),
+			"state_name": stateBackend.Name.ValueString(),
+		})
+		return true
+	}
+
+	// Compare Filters field
+	if o.filtersChanged(ctx, planBackend.Filters, stateBackend.Filters) {
+		tflog.Info(ctx, "Backend Filters changed", map[string]interface{}{
+			"plan_name":  planBackend.Name.ValueString(),
+			"state_name": stateBackend.Name.ValueString(),
+		})
+		return true
+	}
+
+	// Compare ErrorFiles field
+	if o.errorFilesChanged(planBackend.ErrorFiles, stateBackend.ErrorFiles) {
+		tflog.Info(ctx, "Backend ErrorFiles changed", map[string]interface{}{
+			"plan_name":  planBackend.Name.ValueString(),
+			"state_name": stateBackend.Name.ValueString(),
+		})
+		return true
+	}
+
 	// Compare StatsOptions field
 	if o.statsOptionsChanged(ctx, planBackend.StatsOptions, stateBackend.StatsOptions) {
 		tflog.Info(ctx, "Backend StatsOptions changed", map[string]interface{}{
@@ -2044,6 +2739,9 @@ func (o *StackOperations) tcpRequestRuleChanged(ctx context.Context, planTcpRequ
 			planRule.ScInt.ValueInt64() != stateRule.ScInt.ValueInt64() ||
 			planRule.ServerName.ValueString() != stateRule.ServerName.ValueString() ||
 			planRule.ServiceName.ValueString() != stateRule.ServiceName.ValueString() ||
+			planRule.TrackScKey.ValueString() != stateRule.TrackScKey.ValueString() ||
+			planRule.TrackScStickCounter.ValueInt64() != stateRule.TrackScStickCounter.ValueInt64() ||
+			planRule.TrackScTable.ValueString() != stateRule.TrackScTable.ValueString() ||
 			planRule.VarName.ValueString() != stateRule.VarName.ValueString() ||
 			planRule.VarFormat.ValueString() != stateRule.VarFormat.ValueString() ||
 			planRule.VarScope.ValueString() != stateRule.VarScope.ValueString() {
@@ -2105,6 +2803,55 @@ func (o *StackOperations) tcpResponseRuleChanged(ctx context.Context, planTcpRes
 	return false
 }
 
+// stickRulesChanged compares plan vs state stick rules to detect changes
+func (o *StackOperations) stickRulesChanged(ctx context.Context, planStickRules []haproxyStickRuleModel, stateStickRules []haproxyStickRuleModel) bool {
+	if len(planStickRules) != len(stateStickRules) {
+		return true
+	}
+
+	for i, planRule := range planStickRules {
+		stateRule := stateStickRules[i]
+		if planRule.Type.ValueString() != stateRule.Type.ValueString() ||
+			planRule.Pattern.ValueString() != stateRule.Pattern.ValueString() ||
+			planRule.Table.ValueString() != stateRule.Table.ValueString() ||
+			planRule.Cond.ValueString() != stateRule.Cond.ValueString() ||
+			planRule.CondTest.ValueString() != stateRule.CondTest.ValueString() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// serverSwitchingRulesChanged compares plan vs state server switching rules to detect changes
+func (o *StackOperations) serverSwitchingRulesChanged(ctx context.Context, planRules []haproxyServerSwitchingRuleModel, stateRules []haproxyServerSwitchingRuleModel) bool {
+	// If counts are different, there's definitely a change
+	if len(planRules) != len(stateRules) {
+		tflog.Info(ctx, "Server switching rules count changed", map[string]interface{}{
+			"plan_count":  len(planRules),
+			"state_count": len(stateRules),
+		})
+		return true
+	}
+
+	// Compare each rule; the order is significant
+	for i, planRule := range planRules {
+		stateRule := stateRules[i]
+		if planRule.TargetServer.ValueString() != stateRule.TargetServer.ValueString() ||
+			planRule.Cond.ValueString() != stateRule.Cond.ValueString() ||
+			planRule.CondTest.ValueString() != stateRule.CondTest.ValueString() {
+			tflog.Info(ctx, "Server switching rule changed", map[string]interface{}{
+				"rule_index":   i,
+				"plan_server":  planRule.TargetServer.ValueString(),
+				"state_server": stateRule.TargetServer.ValueString(),
+			})
+			return true
+		}
+	}
+
+	return false
+}
+
 // defaultServerChanged compares plan vs state default server to detect changes
 func (o *StackOperations) defaultServerChanged(ctx context.Context, planDefaultServer *haproxyDefaultServerModel, stateDefaultServer *haproxyDefaultServerModel) bool {
 	// If one is nil and the other isn't, there's a change
@@ -2173,6 +2920,141 @@ func (o *StackOperations) stickTableChanged(ctx context.Context, planStickTable
 	return false
 }
 
+// emailAlertChanged compares plan vs state email alert to detect changes
+func (o *StackOperations) emailAlertChanged(planEmailAlert *haproxyEmailAlertModel, stateEmailAlert *haproxyEmailAlertModel) bool {
+	if (planEmailAlert == nil) != (stateEmailAlert == nil) {
+		return true
+	}
+	if planEmailAlert == nil {
+		return false
+	}
+
+	return planEmailAlert.From.ValueString() != stateEmailAlert.From.ValueString() ||
+		planEmailAlert.To.ValueString() != stateEmailAlert.To.ValueString() ||
+		planEmailAlert.Level.ValueString() != stateEmailAlert.Level.ValueString() ||
+		planEmailAlert.Mailers.ValueString() != stateEmailAlert.Mailers.ValueString() ||
+		planEmailAlert.Myhostname.ValueString() != stateEmailAlert.Myhostname.ValueString()
+}
+
+// compressionChanged compares plan vs state compression to detect changes
+func (o *StackOperations) compressionChanged(planCompression *haproxyCompressionModel, stateCompression *haproxyCompressionModel) bool {
+	if (planCompression == nil) != (stateCompression == nil) {
+		return true
+	}
+	if planCompression == nil {
+		return false
+	}
+
+	return !slices.Equal(planCompression.Algorithms, stateCompression.Algorithms) ||
+		!slices.Equal(planCompression.Types, stateCompression.Types) ||
+		planCompression.Offload.ValueBool() != stateCompression.Offload.ValueBool()
+}
+
+// filtersChanged compares plan vs state filters to detect changes
+func (o *StackOperations) filtersChanged(ctx context.Context, planFilters []haproxyFilterModel, stateFilters []haproxyFilterModel) bool {
+	// If counts are different, there's definitely a change
+	if len(planFilters) != len(stateFilters) {
+		tflog.Info(ctx, "Filters count changed", map[string]interface{}{
+			"plan_count":  len(planFilters),
+			"state_count": len(stateFilters),
+		})
+		return true
+	}
+
+	// Compare each filter; the order is significant
+	for i, planFilter := range planFilters {
+		stateFilter := stateFilters[i]
+		if planFilter.Type.ValueString() != stateFilter.Type.ValueString() ||
+			planFilter.AppName.ValueString() != stateFilter.AppName.ValueString() ||
+			planFilter.BandwidthLimitName.ValueString() != stateFilter.BandwidthLimitName.ValueString() ||
+			planFilter.CacheName.ValueString() != stateFilter.CacheName.ValueString() ||
+			planFilter.DefaultLimit.ValueInt64() != stateFilter.DefaultLimit.ValueInt64() ||
+			planFilter.DefaultPeriod.ValueInt64() != stateFilter.DefaultPeriod.ValueInt64() ||
+			planFilter.Key.ValueString() != stateFilter.Key.ValueString() ||
+			planFilter.Limit.ValueInt64() != stateFilter.Limit.ValueInt64() ||
+			planFilter.MinSize.ValueInt64() != stateFilter.MinSize.ValueInt64() ||
+			planFilter.SpoeConfig.ValueString() != stateFilter.SpoeConfig.ValueString() ||
+			planFilter.SpoeEngine.ValueString() != stateFilter.SpoeEngine.ValueString() ||
+			planFilter.Table.ValueString() != stateFilter.Table.ValueString() ||
+			planFilter.TraceName.ValueString() != stateFilter.TraceName.ValueString() ||
+			planFilter.TraceHexdump.ValueBool() != stateFilter.TraceHexdump.ValueBool() ||
+			planFilter.TraceRndForwarding.ValueBool() != stateFilter.TraceRndForwarding.ValueBool() ||
+			planFilter.TraceRndParsing.ValueBool() != stateFilter.TraceRndParsing.ValueBool() {
+			tflog.Info(ctx, "Filter changed", map[string]interface{}{
+				"filter_index": i,
+				"plan_type":    planFilter.Type.ValueString(),
+				"state_type":   stateFilter.Type.ValueString(),
+			})
+			return true
+		}
+	}
+
+	return false
+}
+
+// errorFilesChanged compares plan vs state error pages to detect changes; file is derived
+// from content and not compared
+func (o *StackOperations) errorFilesChanged(planErrorFiles []haproxyErrorFilesModel, stateErrorFiles []haproxyErrorFilesModel) bool {
+	if len(planErrorFiles) != len(stateErrorFiles) {
+		return true
+	}
+
+	for i := range planErrorFiles {
+		if !planErrorFiles[i].Code.Equal(stateErrorFiles[i].Code) ||
+			!planErrorFiles[i].Content.Equal(stateErrorFiles[i].Content) ||
+			!planErrorFiles[i].HttpErrors.Equal(stateErrorFiles[i].HttpErrors) {
+			return true
+		}
+	}
+	return false
+}
+
+// storeErrorPages writes the error page content of the plan to the general storage, reusing
+// the files of state for unchanged pages. Storage is not transactional, so this runs before
+// the transaction that references the files.
+func (o *StackOperations) storeErrorPages(ctx context.Context, data, state *haproxyStackResourceModel) error {
+	errorFilesManager := CreateErrorFilesManager(o.client)
+	if data.Frontend != nil {
+		var previous []haproxyErrorFilesModel
+		if state != nil && state.Frontend != nil {
+			previous = state.Frontend.ErrorFiles
+		}
+		if err := errorFilesManager.Store(ctx, "frontend", data.Frontend.Name.ValueString(), data.Frontend.ErrorFiles, previous); err != nil {
+			return err
+		}
+	}
+	if data.Backend != nil {
+		var previous []haproxyErrorFilesModel
+		if state != nil && state.Backend != nil {
+			previous = state.Backend.ErrorFiles
+		}
+		if err := errorFilesManager.Store(ctx, "backend", data.Backend.Name.ValueString(), data.Backend.ErrorFiles, previous); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// cleanupErrorPages deletes the stored error pages of state that data no longer uses. It
+// runs after the commit; data is nil when the stack is deleted.
+func (o *StackOperations) cleanupErrorPages(ctx context.Context, state, data *haproxyStackResourceModel) {
+	errorFilesManager := CreateErrorFilesManager(o.client)
+	if state.Frontend != nil {
+		var current []haproxyErrorFilesModel
+		if data != nil && data.Frontend != nil {
+			current = data.Frontend.ErrorFiles
+		}
+		errorFilesManager.Cleanup(ctx, state.Frontend.ErrorFiles, current)
+	}
+	if state.Backend != nil {
+		var current []haproxyErrorFilesModel
+		if data != nil && data.Backend != nil {
+			current = data.Backend.ErrorFiles
+		}
+		errorFilesManager.Cleanup(ctx, state.Backend.ErrorFiles, current)
+	}
+}
+
 // bindsChanged compares plan vs state binds to detect changes
 func (o *StackOperations) bindsChanged(ctx context.Context, planBinds map[string]haproxyBindModel, stateBinds map[string]haproxyBindModel) bool {
 	// If counts are different, there's definitely a change
@@ -2328,6 +3210,47 @@ func (o *StackOperations) serversChanged(ctx context.Context, planServers map[st
 			planServer.Ssl.ValueString() != stateServer.Ssl.ValueString() ||
 			planServer.Verify.ValueString() != stateServer.Verify.ValueString() ||
 			planServer.Cookie.ValueString() != stateServer.Cookie.ValueString() ||
+			planServer.Maxqueue.ValueInt64() != stateServer.Maxqueue.ValueInt64() ||
+			planServer.Minconn.ValueInt64() != stateServer.Minconn.ValueInt64() ||
+			planServer.Slowstart.ValueInt64() != stateServer.Slowstart.ValueInt64() ||
+			planServer.InitAddr.ValueString() != stateServer.InitAddr.ValueString() ||
+			planServer.Maintenance.ValueString() != stateServer.Maintenance.ValueString() ||
+			planServer.Track.ValueString() != stateServer.Track.ValueString() ||
+			planServer.Observe.ValueString() != stateServer.Observe.ValueString() ||
+			planServer.ErrorLimit.ValueInt64() != stateServer.ErrorLimit.ValueInt64() ||
+			planServer.OnError.ValueString() != stateServer.OnError.ValueString() ||
+			planServer.OnMarkedDown.ValueString() != stateServer.OnMarkedDown.ValueString() ||
+			planServer.OnMarkedUp.ValueString() != stateServer.OnMarkedUp.ValueString() ||
+			planServer.HealthCheckPort.ValueInt64() != stateServer.HealthCheckPort.ValueInt64() ||
+			planServer.AgentCheck.ValueString() != stateServer.AgentCheck.ValueString() ||
+			planServer.AgentAddr.ValueString() != stateServer.AgentAddr.ValueString() ||
+			planServer.AgentPort.ValueInt64() != stateServer.AgentPort.ValueInt64() ||
+			planServer.AgentInter.ValueInt64() != stateServer.AgentInter.ValueInt64() ||
+			planServer.AgentSend.ValueString() != stateServer.AgentSend.ValueString() ||
+			planServer.Proto.ValueString() != stateServer.Proto.ValueString() ||
+			planServer.Alpn.ValueString() != stateServer.Alpn.ValueString() ||
+			planServer.Sni.ValueString() != stateServer.Sni.ValueString() ||
+			planServer.Verifyhost.ValueString() != stateServer.Verifyhost.ValueString() ||
+			planServer.Ciphers.ValueString() != stateServer.Ciphers.ValueString() ||
+			planServer.Ciphersuites.ValueString() != stateServer.Ciphersuites.ValueString() ||
+			planServer.SslReuse.ValueString() != stateServer.SslReuse.ValueString() ||
+			planServer.TlsTickets.ValueString() != stateServer.TlsTickets.ValueString() ||
+			planServer.CheckSsl.ValueString() != stateServer.CheckSsl.ValueString() ||
+			planServer.CheckSni.ValueString() != stateServer.CheckSni.ValueString() ||
+			planServer.CheckAlpn.ValueString() != stateServer.CheckAlpn.ValueString() ||
+			planServer.SendProxy.ValueString() != stateServer.SendProxy.ValueString() ||
+			planServer.SendProxyV2.ValueString() != stateServer.SendProxyV2.ValueString() ||
+			planServer.SendProxyV2Ssl.ValueString() != stateServer.SendProxyV2Ssl.ValueString() ||
+			planServer.SendProxyV2SslCn.ValueString() != stateServer.SendProxyV2SslCn.ValueString() ||
+			planServer.PoolLowConn.ValueInt64() != stateServer.PoolLowConn.ValueInt64() ||
+			planServer.PoolMaxConn.ValueInt64() != stateServer.PoolMaxConn.ValueInt64() ||
+			planServer.PoolPurgeDelay.ValueInt64() != stateServer.PoolPurgeDelay.ValueInt64() ||
+			planServer.Source.ValueString() != stateServer.Source.ValueString() ||
+			planServer.Redir.ValueString() != stateServer.Redir.ValueString() ||
+			planServer.Stick.ValueString() != stateServer.Stick.ValueString() ||
+			planServer.Tfo.ValueString() != stateServer.Tfo.ValueString() ||
+			planServer.LogProto.ValueString() != stateServer.LogProto.ValueString() ||
+			!slices.Equal(planServer.ProxyV2Options, stateServer.ProxyV2Options) ||
 			planServer.Sslv3.ValueString() != stateServer.Sslv3.ValueString() ||
 			planServer.Tlsv10.ValueString() != stateServer.Tlsv10.ValueString() ||
 			planServer.Tlsv11.ValueString() != stateServer.Tlsv11.ValueString() ||
@@ -2436,6 +3359,14 @@ func (o *StackOperations) deleteSingleInternal(ctx context.Context, req resource
 		}
 	}
 
+	// Delete Backend Switching Rules if specified
+	if data.Frontend != nil && len(data.Frontend.BackendSwitchingRules) > 0 {
+		tflog.Info(ctx, "Deleting backend switching rules", map[string]interface{}{"frontend_name": data.Frontend.Name.ValueString()})
+		if err = o.backendSwitchingRuleManager.Delete(ctx, transactionID, data.Frontend.Name.ValueString()); err != nil {
+			return fmt.Errorf("error deleting backend switching rules: %w", err)
+		}
+	}
+
 	// Delete Frontend HTTP Response Rules if specified
 	if data.Frontend != nil && data.Frontend.HttpResponseRules != nil && len(data.Frontend.HttpResponseRules) > 0 {
 		tflog.Info(ctx, "Deleting frontend HTTP response rules", map[string]interface{}{"frontend_name": data.Frontend.Name.ValueString()})
@@ -2452,6 +3383,22 @@ func (o *StackOperations) deleteSingleInternal(ctx context.Context, req resource
 		}
 	}
 
+	// Delete Frontend HTTP After-Response Rules if specified
+	if data.Frontend != nil && len(data.Frontend.HttpAfterResponseRules) > 0 {
+		tflog.Info(ctx, "Deleting frontend HTTP after-response rules", map[string]interface{}{"frontend_name": data.Frontend.Name.ValueString()})
+		if err = o.httpAfterResponseRuleManager.Delete(ctx, transactionID, "frontend", data.Frontend.Name.ValueString()); err != nil {
+			return fmt.Errorf("error deleting frontend HTTP after-response rules: %w", err)
+		}
+	}
+
+	// Delete Backend HTTP After-Response Rules if specified
+	if data.Backend != nil && len(data.Backend.HttpAfterResponseRules) > 0 {
+		tflog.Info(ctx, "Deleting backend HTTP after-response rules", map[string]interface{}{"backend_name": data.Backend.Name.ValueString()})
+		if err = o.httpAfterResponseRuleManager.Delete(ctx, transactionID, "backend", data.Backend.Name.ValueString()); err != nil {
+			return fmt.Errorf("error deleting backend HTTP after-response rules: %w", err)
+		}
+	}
+
 	// Delete Frontend TCP Request Rules if specified
 	if data.Frontend != nil && data.Frontend.TcpRequestRules != nil && len(data.Frontend.TcpRequestRules) > 0 {
 		tflog.Info(ctx, "Deleting frontend TCP request rules", map[string]interface{}{"frontend_name": data.Frontend.Name.ValueString()})
@@ -2476,6 +3423,38 @@ func (o *StackOperations) deleteSingleInternal(ctx context.Context, req resource
 		}
 	}
 
+	// Delete Backend Stick Rules if specified
+	if data.Backend != nil && len(data.Backend.StickRules) > 0 {
+		tflog.Info(ctx, "Deleting backend stick rules", map[string]interface{}{"backend_name": data.Backend.Name.ValueString()})
+		if err = o.stickRuleManager.Delete(ctx, transactionID, data.Backend.Name.ValueString()); err != nil {
+			return fmt.Errorf("error deleting backend stick rules: %w", err)
+		}
+	}
+
+	// Delete Backend Server Switching Rules if specified
+	if data.Backend != nil && len(data.Backend.ServerSwitchingRules) > 0 {
+		tflog.Info(ctx, "Deleting backend server switching rules", map[string]interface{}{"backend_name": data.Backend.Name.ValueString()})
+		if err = o.serverSwitchingRuleManager.Delete(ctx, transactionID, data.Backend.Name.ValueString()); err != nil {
+			return fmt.Errorf("error deleting backend server switching rules: %w", err)
+		}
+	}
+
+	// Delete Frontend Filters if specified
+	if data.Frontend != nil && len(data.Frontend.Filters) > 0 {
+		tflog.Info(ctx, "Deleting frontend filters", map[string]interface{}{"frontend_name": data.Frontend.Name.ValueString()})
+		if err = o.filterManager.Delete(ctx, transactionID, "frontend", data.Frontend.Name.ValueString()); err != nil {
+			return fmt.Errorf("error deleting frontend filters: %w", err)
+		}
+	}
+
+	// Delete Backend Filters if specified
+	if data.Backend != nil && len(data.Backend.Filters) > 0 {
+		tflog.Info(ctx, "Deleting backend filters", map[string]interface{}{"backend_name": data.Backend.Name.ValueString()})
+		if err = o.filterManager.Delete(ctx, transactionID, "backend", data.Backend.Name.ValueString()); err != nil {
+			return fmt.Errorf("error deleting backend filters: %w", err)
+		}
+	}
+
 	// Delete Backend HTTP Checks if specified
 	if data.Backend != nil && data.Backend.Httpchecks != nil && len(data.Backend.Httpchecks) > 0 {
 		tflog.Info(ctx, "Deleting backend HTTP checks", map[string]interface{}{"backend_name": data.Backend.Name.ValueString()})
@@ -2556,6 +3535,9 @@ func (o *StackOperations) deleteSingleInternal(ctx context.Context, req resource
 
 	// Clear the error so defer doesn't rollback
 	err = nil
+
+	// Remove the stored error pages of the deleted frontend and backend
+	o.cleanupErrorPages(ctx, data, nil)
 	tflog.Info(ctx, "HAProxy stack deleted successfully")
 	return nil
 }
@@ -2595,6 +3577,9 @@ func (o *StackOperations) convertTcpRequestRulesToResourceModels(stackRules []ha
 			ScInt:                stackRule.ScInt,
 			ServerName:           stackRule.ServerName,
 			ServiceName:          stackRule.ServiceName,
+			TrackKey:             stackRule.TrackScKey,
+			TrackStickCounter:    stackRule.TrackScStickCounter,
+			TrackTable:           stackRule.TrackScTable,
 			VarName:              stackRule.VarName,
 			VarFormat:            stackRule.VarFormat,
 			VarScope:             stackRule.VarScope,
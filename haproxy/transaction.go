@@ -270,6 +270,47 @@ func (c *HAProxyClient) CommitTransactionWithRetry(transactionID string) error {
 	return fmt.Errorf("failed to commit transaction %s after %d attempts", transactionID, maxRetries)
 }
 
+// InTransaction runs fn inside a fresh transaction and commits it, retrying the whole
+// sequence up to maxRetries times when the transaction becomes outdated. It holds
+// globalTransactionMutex so that standalone resources never interleave with an
+// haproxy_stack transaction.
+func (c *HAProxyClient) InTransaction(fn func(transactionID string) error) error {
+	globalTransactionMutex.Lock()
+	defer globalTransactionMutex.Unlock()
+
+	maxRetries := 3
+	var err error
+	for attempt := 0; attempt < maxRetries; attempt++ {
+		if attempt > 0 {
+			time.Sleep(retryDelay)
+		}
+
+		var transactionID string
+		transactionID, err = c.BeginTransaction()
+		if err != nil {
+			return fmt.Errorf("error beginning transaction: %w", err)
+		}
+
+		if err = fn(transactionID); err == nil {
+			err = c.CommitTransaction(transactionID)
+			if err == nil {
+				return nil
+			}
+		}
+
+		if rollbackErr := c.RollbackTransaction(transactionID); rollbackErr != nil {
+			log.Printf("Warning: Failed to rollback transaction %s: %v", transactionID, rollbackErr)
+		}
+
+		if !isRetryableCommitError(err) {
+			return err
+		}
+		log.Printf("Transaction %s outdated, retrying (attempt %d of %d): %v", transactionID, attempt+1, maxRetries, err)
+	}
+
+	return fmt.Errorf("transaction still outdated after %d attempts: %w", maxRetries, err)
+}
+
 // isRetryableCommitError checks if a commit error is retryable
 func isRetryableCommitError(err error) bool {
 	// Check for CustomError first
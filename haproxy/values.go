@@ -0,0 +1,39 @@
+package haproxy
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// The Data Plane API omits unset fields, so zero values read back from HAProxy are
+// mapped to null to match attributes that were never configured.
+
+// int64OrNull returns a null Int64 for the zero value.
+func int64OrNull(v int64) types.Int64 {
+	if v == 0 {
+		return types.Int64Null()
+	}
+	return types.Int64Value(v)
+}
+
+// stringOrNull returns a null String for the empty string.
+func stringOrNull(v string) types.String {
+	if v == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(v)
+}
+
+// boolOrNull returns a null Bool for false.
+func boolOrNull(v bool) types.Bool {
+	if !v {
+		return types.BoolNull()
+	}
+	return types.BoolValue(true)
+}
+
+// boolOrPrior returns a null Bool for false unless the prior value was set, so a
+// configured false is not refreshed as null.
+func boolOrPrior(v bool, prior types.Bool) types.Bool {
+	if !v && prior.IsNull() {
+		return types.BoolNull()
+	}
+	return types.BoolValue(v)
+}